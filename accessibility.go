@@ -0,0 +1,140 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Accessibility audit rules.
+const (
+	RuleAltText     = "a11y-alt-text"
+	RuleColorOnly   = "a11y-color-only"
+	RuleHeading     = "a11y-heading"
+	RuleInputLabel  = "a11y-input-label"
+	RuleActionTitle = "a11y-action-title"
+)
+
+// vagueTitles are action titles that say nothing about where they lead
+// when read out of context by a screen reader.
+var vagueTitles = map[string]bool{
+	"": true, "click here": true, "here": true, "click": true, "link": true,
+	"more": true, "read more": true, "this": true, "go": true,
+}
+
+// statusWordRE matches words that state a status in text, so color is not
+// the only signal.
+var statusWordRE = regexp.MustCompile(`(?i)\b(critical|high|medium|low|info|error|errors|warning|warn|failed|failure|failing|success|succeeded|passed|ok|resolved|healthy|unhealthy|degraded|outage)\b`)
+
+// AuditAccessibility flags images without alt text, status conveyed by color
+// alone, heading-like text not marked as a heading, unlabeled inputs and
+// action titles that don't describe the action ("Click here").
+func (c AdaptiveCard) AuditAccessibility() []Finding {
+	var a accessibilityAudit
+	a.card(c, "$")
+	return a.findings
+}
+
+type accessibilityAudit struct {
+	findings []Finding
+}
+
+func (a *accessibilityAudit) add(path, rule, format string, args ...any) {
+	a.findings = append(a.findings, Finding{Path: path, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *accessibilityAudit) card(c AdaptiveCard, path string) {
+	_ = walk(c.Body, path+".body", func(path string, el Element) error {
+		a.element(path, el)
+		for _, sel := range selectActions(path, el) {
+			a.action(sel.path, sel.action)
+		}
+		if as, ok := el.(ActionSet); ok {
+			for i, act := range flatActions(as.Actions) {
+				actionPath := fmt.Sprintf("%s.actions[%d]", path, i)
+				a.action(actionPath, act)
+				if act.Card != nil {
+					a.card(*act.Card, actionPath+".card")
+				}
+			}
+		}
+		return nil
+	})
+	for i, act := range flatActions(c.Actions) {
+		actionPath := fmt.Sprintf("%s.actions[%d]", path, i)
+		a.action(actionPath, act)
+		if act.Card != nil {
+			a.card(*act.Card, actionPath+".card")
+		}
+	}
+	if c.SelectAction != nil {
+		a.action(path+".selectAction", *c.SelectAction)
+	}
+}
+
+func (a *accessibilityAudit) element(path string, el Element) {
+	switch el := el.(type) {
+	case Image:
+		if el.AltText == "" {
+			a.add(path, RuleAltText, "image has no altText")
+		}
+	case Media:
+		if el.AltText == "" {
+			a.add(path, RuleAltText, "media has no altText")
+		}
+	case TextBlock:
+		if isStatusColor(string(el.Color)) && !statusWordRE.MatchString(el.Text) {
+			a.add(path, RuleColorOnly, "%s text color is the only status signal; say the status in words", el.Color)
+		}
+		if el.Style != "heading" && el.Weight == WeightBolder && (el.Size == SizeLarge || el.Size == SizeExtraLarge) {
+			a.add(path, RuleHeading, "large bold text should use style \"heading\" so screen readers announce it")
+		}
+	case RichTextBlock:
+		for i, run := range el.Inlines {
+			if isStatusColor(string(run.Color)) && !statusWordRE.MatchString(run.Text) {
+				a.add(fmt.Sprintf("%s.inlines[%d]", path, i), RuleColorOnly,
+					"%s text color is the only status signal; say the status in words", run.Color)
+			}
+		}
+	case Container:
+		if isStatusColor(string(el.Style)) && !hasStatusSignal(el.Items) {
+			a.add(path, RuleColorOnly, "%s container style is the only status signal; add an icon, badge or status text", el.Style)
+		}
+	}
+	if in, ok := inputFields(el); ok && in.Label == "" {
+		a.add(path, RuleInputLabel, "input %q has no label", in.ID)
+	}
+}
+
+func (a *accessibilityAudit) action(path string, act Action) {
+	title := strings.ToLower(strings.TrimSpace(act.Title))
+	if vagueTitles[title] {
+		a.add(path, RuleActionTitle, "action title %q does not describe the action", act.Title)
+	}
+}
+
+func isStatusColor(color string) bool {
+	switch color {
+	case "good", "warning", "attention":
+		return true
+	}
+	return false
+}
+
+// hasStatusSignal reports whether items state a status other than by color:
+// an icon, a badge or status wording.
+func hasStatusSignal(items []Element) bool {
+	found := false
+	_ = walk(items, "", func(path string, el Element) error {
+		switch el.(type) {
+		case Icon, Badge:
+			found = true
+		default:
+			elementTexts(path, el, func(_, text string) {
+				found = found || statusWordRE.MatchString(text)
+			})
+		}
+		return nil
+	})
+	return found
+}
@@ -0,0 +1,72 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Values of Action.AssociatedInputs.
+const (
+	AssociatedInputsAuto = "auto"
+	AssociatedInputsNone = "none"
+)
+
+// NewSubmitAction returns an Action.Submit carrying data; see NewSubmit for
+// a typed variant.
+func NewSubmitAction(title string, data any) SubmitAction {
+	return SubmitAction{
+		Type:  "Action.Submit",
+		Title: title,
+		Data:  data,
+	}
+}
+
+// WithAssociatedInputs sets whether pressing a Submit or Execute action
+// validates and sends the card's inputs (AssociatedInputsAuto) or not
+// (AssociatedInputsNone), e.g. for a "Cancel" button on a form.
+func (a *Action) WithAssociatedInputs(mode string) {
+	a.AssociatedInputs = mode
+}
+
+// NewSubmit returns an Action.Submit carrying data, which Teams sends back to
+// the bot (merged with any input values) when the button is pressed.
+func NewSubmit[T any](title string, data T) SubmitAction {
+	return SubmitAction{
+		Type:  "Action.Submit",
+		Title: title,
+		Data:  data,
+	}
+}
+
+// NewExecute is the typed counterpart of NewExecuteAction.
+func NewExecute[T any](title, verb string, data T) ExecuteAction {
+	return NewExecuteAction(title, verb, data)
+}
+
+// DecodeSubmitData decodes the payload of a pressed Submit or Execute action
+// into T. invokeValue is the activity "value" Teams delivers: the data object
+// itself for Action.Submit, or the adaptiveCard/action invoke value (whose
+// action.data is decoded) for Action.Execute.
+func DecodeSubmitData[T any](invokeValue []byte) (T, error) {
+	var data T
+
+	var invoke struct {
+		Action *struct {
+			Type string          `json:"type"`
+			Data json.RawMessage `json:"data"`
+		} `json:"action"`
+	}
+	payload := invokeValue
+	if json.Unmarshal(invokeValue, &invoke) == nil && invoke.Action != nil && strings.HasPrefix(invoke.Action.Type, "Action.") {
+		payload = invoke.Action.Data
+	}
+	if len(payload) == 0 {
+		return data, nil
+	}
+
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return data, fmt.Errorf("adaptivecard: decoding action data: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,210 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ActionElement is implemented by every action type: OpenUrlAction,
+// SubmitAction, ExecuteAction, ShowCardAction, ToggleVisibilityAction and
+// RawAction. The flat Action struct also implements it, so code written
+// against it keeps working. IconURL, Style ("default", "positive" or "destructive"),
+// Tooltip and IsEnabled are common to every type, and each type's Extra
+// holds properties it has no field for; see BaseElement.Extra.
+type ActionElement interface {
+	isAction()
+	// flat returns the action as the flat Action struct, the common form
+	// used for serialization and by the tree walkers.
+	flat() Action
+}
+
+func (Action) isAction()      {}
+func (a Action) flat() Action { return a }
+
+// typedAction returns the concrete action type for a flat Action; unknown
+// types are returned as is.
+func typedAction(a Action) ActionElement {
+	switch a.Type {
+	case "Action.OpenUrl":
+		return OpenUrlAction{Type: a.Type, Title: a.Title, URL: a.Url, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+	case "Action.Submit":
+		return SubmitAction{Type: a.Type, Title: a.Title, Data: a.Data, AssociatedInputs: a.AssociatedInputs, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+	case "Action.Execute":
+		return ExecuteAction{Type: a.Type, Title: a.Title, Verb: a.Verb, Data: a.Data, AssociatedInputs: a.AssociatedInputs, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+	case "Action.ShowCard":
+		return ShowCardAction{Type: a.Type, Title: a.Title, Card: a.Card, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+	case "Action.ToggleVisibility":
+		return ToggleVisibilityAction{Type: a.Type, Title: a.Title, TargetElements: a.TargetElements, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+	}
+	return a
+}
+
+// replaceAction returns updated, a modified copy of orig's flat form, in the
+// same representation as orig: flat Actions stay flat, and RawActions stay
+// raw unless updated differs from what they decode to.
+func replaceAction(orig ActionElement, updated Action) ActionElement {
+	switch orig := orig.(type) {
+	case Action:
+		return updated
+	case RawAction:
+		if reflect.DeepEqual(orig.flat(), updated) {
+			return orig
+		}
+	}
+	return typedAction(updated)
+}
+
+// mapActions returns a copy of actions with fn applied to the flat form of
+// each, keeping each action's representation.
+func mapActions(actions []ActionElement, fn func(Action) Action) []ActionElement {
+	if actions == nil {
+		return nil
+	}
+	out := make([]ActionElement, len(actions))
+	for i, a := range actions {
+		out[i] = replaceAction(a, fn(a.flat()))
+	}
+	return out
+}
+
+func flatActions(actions []ActionElement) []Action {
+	out := make([]Action, len(actions))
+	for i, a := range actions {
+		out[i] = a.flat()
+	}
+	return out
+}
+
+// ----------------------
+// Action.OpenUrl
+// ----------------------
+type OpenUrlAction struct {
+	Type      string                     `json:"type"`
+	Title     string                     `json:"title"`
+	URL       string                     `json:"url"`
+	Mode      string                     `json:"mode,omitempty"`
+	IconURL   string                     `json:"iconUrl,omitempty"`
+	Style     string                     `json:"style,omitempty"`
+	Tooltip   string                     `json:"tooltip,omitempty"`
+	IsEnabled *bool                      `json:"isEnabled,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func NewOpenUrlAction(title, url string) OpenUrlAction {
+	return OpenUrlAction{
+		Type:  "Action.OpenUrl",
+		Title: title,
+		URL:   url,
+	}
+}
+func (OpenUrlAction) isAction() {}
+func (a OpenUrlAction) flat() Action {
+	return Action{Type: a.Type, Title: a.Title, Url: a.URL, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+}
+func (a OpenUrlAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.flat())
+}
+
+// ----------------------
+// Action.Submit
+// ----------------------
+type SubmitAction struct {
+	Type             string                     `json:"type"`
+	Title            string                     `json:"title"`
+	Data             any                        `json:"data,omitempty"`
+	AssociatedInputs string                     `json:"associatedInputs,omitempty"`
+	Mode             string                     `json:"mode,omitempty"`
+	IconURL          string                     `json:"iconUrl,omitempty"`
+	Style            string                     `json:"style,omitempty"`
+	Tooltip          string                     `json:"tooltip,omitempty"`
+	IsEnabled        *bool                      `json:"isEnabled,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (SubmitAction) isAction() {}
+func (a SubmitAction) flat() Action {
+	return Action{Type: a.Type, Title: a.Title, Data: a.Data, AssociatedInputs: a.AssociatedInputs, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+}
+func (a SubmitAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.flat())
+}
+
+// WithAssociatedInputs is Action.WithAssociatedInputs for typed actions.
+func (a *SubmitAction) WithAssociatedInputs(mode string) {
+	a.AssociatedInputs = mode
+}
+
+// ----------------------
+// Action.Execute
+// ----------------------
+type ExecuteAction struct {
+	Type             string                     `json:"type"`
+	Title            string                     `json:"title"`
+	Verb             string                     `json:"verb,omitempty"`
+	Data             any                        `json:"data,omitempty"`
+	AssociatedInputs string                     `json:"associatedInputs,omitempty"`
+	Mode             string                     `json:"mode,omitempty"`
+	IconURL          string                     `json:"iconUrl,omitempty"`
+	Style            string                     `json:"style,omitempty"`
+	Tooltip          string                     `json:"tooltip,omitempty"`
+	IsEnabled        *bool                      `json:"isEnabled,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (ExecuteAction) isAction() {}
+func (a ExecuteAction) flat() Action {
+	return Action{Type: a.Type, Title: a.Title, Verb: a.Verb, Data: a.Data, AssociatedInputs: a.AssociatedInputs, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+}
+func (a ExecuteAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.flat())
+}
+
+func (a *ExecuteAction) WithAssociatedInputs(mode string) {
+	a.AssociatedInputs = mode
+}
+
+// ----------------------
+// Action.ShowCard
+// ----------------------
+type ShowCardAction struct {
+	Type      string                     `json:"type"`
+	Title     string                     `json:"title"`
+	Card      *AdaptiveCard              `json:"card"`
+	Mode      string                     `json:"mode,omitempty"`
+	IconURL   string                     `json:"iconUrl,omitempty"`
+	Style     string                     `json:"style,omitempty"`
+	Tooltip   string                     `json:"tooltip,omitempty"`
+	IsEnabled *bool                      `json:"isEnabled,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (ShowCardAction) isAction() {}
+func (a ShowCardAction) flat() Action {
+	return Action{Type: a.Type, Title: a.Title, Card: a.Card, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+}
+func (a ShowCardAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.flat())
+}
+
+// ----------------------
+// Action.ToggleVisibility
+// ----------------------
+type ToggleVisibilityAction struct {
+	Type           string                     `json:"type"`
+	Title          string                     `json:"title"`
+	TargetElements []TargetElement            `json:"targetElements"`
+	Mode           string                     `json:"mode,omitempty"`
+	IconURL        string                     `json:"iconUrl,omitempty"`
+	Style          string                     `json:"style,omitempty"`
+	Tooltip        string                     `json:"tooltip,omitempty"`
+	IsEnabled      *bool                      `json:"isEnabled,omitempty"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (ToggleVisibilityAction) isAction() {}
+func (a ToggleVisibilityAction) flat() Action {
+	return Action{Type: a.Type, Title: a.Title, TargetElements: a.TargetElements, Mode: a.Mode, IconURL: a.IconURL, Style: a.Style, Tooltip: a.Tooltip, IsEnabled: a.IsEnabled, Extra: a.Extra}
+}
+func (a ToggleVisibilityAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.flat())
+}
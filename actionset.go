@@ -0,0 +1,28 @@
+package adaptivecard
+
+// ----------------------
+// ActionSet
+// ----------------------
+
+// ActionSet places buttons inline in the body, e.g. inside a Container,
+// Column or TableCell, rather than in the card's action row.
+type ActionSet struct {
+	Type string `json:"type"`
+	BaseElement
+	Actions []ActionElement `json:"actions"`
+}
+
+func NewActionSet(actions ...ActionElement) ActionSet {
+	return ActionSet{
+		Type:    "ActionSet",
+		Actions: actions,
+	}
+}
+func (ActionSet) isElement() {}
+func (as ActionSet) toRaw() any {
+	return as
+}
+
+func (as *ActionSet) AddAction(action ActionElement) {
+	as.Actions = append(as.Actions, action)
+}
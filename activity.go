@@ -0,0 +1,88 @@
+package adaptivecard
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ContentType is the attachment content type of an Adaptive Card.
+const ContentType = "application/vnd.microsoft.card.adaptive"
+
+// Attachment wraps a card for delivery inside a message.
+type Attachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+func NewAttachment(card AdaptiveCard) Attachment {
+	return Attachment{
+		ContentType: ContentType,
+		Content:     card,
+	}
+}
+
+// Activity is a Bot Framework message activity carrying card attachments. The
+// same envelope is accepted by Teams incoming webhooks.
+type Activity struct {
+	Type        string       `json:"type"`
+	ID          string       `json:"id,omitempty"`
+	Text        string       `json:"text,omitempty"`
+	Attachments []Attachment `json:"attachments"`
+	ChannelData *ChannelData `json:"channelData,omitempty"`
+}
+
+// ChannelData holds the Teams specific properties of an activity.
+type ChannelData struct {
+	FeedbackLoopEnabled bool          `json:"feedbackLoopEnabled,omitempty"`
+	FeedbackLoop        *FeedbackLoop `json:"feedbackLoop,omitempty"`
+}
+
+// FeedbackLoop selects the feedback experience shown under a bot message:
+// "default" shows thumbs up/down with a built-in form, "custom" sends the bot
+// a message/fetchTask invoke so it can show its own dialog.
+type FeedbackLoop struct {
+	Type string `json:"type"`
+}
+
+func NewMessageActivity(cards ...AdaptiveCard) Activity {
+	attachments := make([]Attachment, len(cards))
+	for i, card := range cards {
+		attachments[i] = NewAttachment(card)
+	}
+	return Activity{
+		Type:        "message",
+		Attachments: attachments,
+	}
+}
+
+// EnableFeedback shows the Teams thumbs up/down feedback buttons on the
+// message. With custom set, Teams asks the bot for its own feedback dialog
+// instead of using the built-in form.
+func (a *Activity) EnableFeedback(custom bool) {
+	if a.ChannelData == nil {
+		a.ChannelData = &ChannelData{}
+	}
+	a.ChannelData.FeedbackLoopEnabled = true
+	a.ChannelData.FeedbackLoop = &FeedbackLoop{Type: "default"}
+	if custom {
+		a.ChannelData.FeedbackLoop.Type = "custom"
+	}
+}
+
+// NewUpdateActivity returns the payload that replaces the card of a message
+// already posted by a bot, e.g. to flip an incident card from "Pending" to
+// "Resolved". Send it with PUT to UpdateActivityURL.
+func NewUpdateActivity(activityID string, card AdaptiveCard) Activity {
+	a := NewMessageActivity(card)
+	a.ID = activityID
+	return a
+}
+
+// UpdateActivityURL returns the Bot Connector endpoint for updating
+// activityID in a conversation. serviceURL is the one received on the
+// incoming activity.
+func UpdateActivityURL(serviceURL, conversationID, activityID string) string {
+	return strings.TrimSuffix(serviceURL, "/") + "/v3/conversations/" +
+		url.PathEscape(conversationID) + "/activities/" + url.PathEscape(activityID)
+}
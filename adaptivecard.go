@@ -5,14 +5,42 @@ import (
 	"fmt"
 )
 
+// SchemaURL is the $schema that identifies a card as an Adaptive Card to
+// editors and the Adaptive Cards Designer.
+const SchemaURL = "http://adaptivecards.io/schemas/adaptive-card.json"
+
 // AdaptiveCard root
 type AdaptiveCard struct {
-	Type    string       `json:"type"`
-	Version string       `json:"version"`
-	Body    []Element    `json:"body"`
-	Schema  string       `json:"$schema"`
-	Actions []Action     `json:"actions,omitempty"`
-	MSTeams *MSTeamsInfo `json:"msteams,omitempty"`
+	Type    string          `json:"type"`
+	Version string          `json:"version"`
+	Body    []Element       `json:"body"`
+	Schema  string          `json:"$schema"`
+	Actions []ActionElement `json:"actions,omitempty"`
+	MSTeams *MSTeamsInfo    `json:"msteams,omitempty"`
+	Refresh *Refresh        `json:"refresh,omitempty"`
+	// Authentication configures sign-in for the card's Universal Actions.
+	Authentication *Authentication `json:"authentication,omitempty"`
+	// SelectAction runs when the card itself is tapped.
+	SelectAction *Action `json:"selectAction,omitempty"`
+	// FallbackText is shown by clients that cannot render the card and in
+	// notifications; see AutoFallbackText.
+	FallbackText string `json:"fallbackText,omitempty"`
+	// Speak is the SSML or plain text read aloud instead of the card's text.
+	Speak string `json:"speak,omitempty"`
+	// Lang is the card's locale, e.g. "en-US", used to format dates and
+	// pick a voice.
+	Lang string `json:"lang,omitempty"`
+	// Extra holds card properties the package has no field for, e.g. rtl
+	// or minHeight; see BaseElement.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	middleware []Middleware
+}
+
+// NewAdaptiveCard returns an empty card of the given schema version with
+// $schema set to SchemaURL.
+func NewAdaptiveCard(version string) AdaptiveCard {
+	return AdaptiveCard{Type: "AdaptiveCard", Version: version, Schema: SchemaURL}
 }
 
 // --- ELEMENT INTERFACE ---
@@ -25,45 +53,111 @@ type Element interface {
 // TextBlock
 // ----------------------
 type TextBlock struct {
-	Type      string `json:"type"`
-	Text      string `json:"text"`
-	Weight    string `json:"weight,omitempty"`
-	Size      string `json:"size,omitempty"`
-	Wrap      bool   `json:"wrap,omitempty"`
-	Separator bool   `json:"separator,omitempty"`
-}
-
-func NewTextBlock(text string) TextBlock {
-	return TextBlock{
+	Type string `json:"type"`
+	BaseElement
+	Text                string `json:"text"`
+	Style               string `json:"style,omitempty"`
+	Weight              Weight `json:"weight,omitempty"`
+	Size                Size   `json:"size,omitempty"`
+	Color               Color  `json:"color,omitempty"`
+	FontType            string `json:"fontType,omitempty"`
+	HorizontalAlignment string `json:"horizontalAlignment,omitempty"`
+	IsSubtle            *bool  `json:"isSubtle,omitempty"`
+	MaxLines            int    `json:"maxLines,omitempty"`
+	Wrap                *bool  `json:"wrap,omitempty"`
+}
+
+func NewTextBlock(text string, opts ...TextBlockOption) TextBlock {
+	t := TextBlock{
 		Type: "TextBlock",
 		Text: text,
-		Wrap: true,
+		Wrap: Bool(true),
 	}
+	for _, opt := range opts {
+		opt.applyTextBlock(&t)
+	}
+	return t
 }
 func (TextBlock) isElement() {}
 func (t TextBlock) toRaw() any {
 	return t
 }
 
-func (t *TextBlock) WithWeight(weight string) {
+func (t *TextBlock) WithWeight(weight Weight) {
 	t.Weight = weight
 }
 
-func (t *TextBlock) WithSize(size string) {
+func (t *TextBlock) WithSize(size Size) {
 	t.Size = size
 }
 
-func (t *TextBlock) WithSeparator() {
-	t.Separator = true
+func (t *TextBlock) WithColor(color Color) {
+	t.Color = color
+}
+
+// WithSubtle de-emphasizes the text, e.g. for captions and timestamps.
+func (t *TextBlock) WithSubtle() {
+	t.IsSubtle = Bool(true)
+}
+
+// WithWrap sets whether the text wraps; NewTextBlock turns wrapping on.
+func (t *TextBlock) WithWrap(wrap bool) {
+	t.Wrap = &wrap
+}
+
+// WithStyle sets the text style; "heading" marks the block as a heading for
+// screen readers.
+func (t *TextBlock) WithStyle(style string) {
+	t.Style = style
+}
+
+// WithHeading sets style "heading".
+func (t *TextBlock) WithHeading() {
+	t.Style = "heading"
+}
+
+// WithFontType selects "default" or "monospace", e.g. for IDs and hashes.
+func (t *TextBlock) WithFontType(fontType string) {
+	t.FontType = fontType
+}
+
+// WithHorizontalAlignment sets "left", "center" or "right".
+func (t *TextBlock) WithHorizontalAlignment(alignment string) {
+	t.HorizontalAlignment = alignment
+}
+
+// WithMaxLines truncates wrapped text to n lines.
+func (t *TextBlock) WithMaxLines(n int) {
+	t.MaxLines = n
 }
 
 // ----------------------
 // Container
 // ----------------------
 type Container struct {
-	Type      string    `json:"type"`
-	Separator bool      `json:"separator"`
-	Items     []Element `json:"items"`
+	Type string `json:"type"`
+	BaseElement
+	Style                    ContainerStyle   `json:"style,omitempty"`
+	Bleed                    bool             `json:"bleed,omitempty"`
+	MinHeight                string           `json:"minHeight,omitempty"`
+	VerticalContentAlignment string           `json:"verticalContentAlignment,omitempty"`
+	BackgroundImage          *BackgroundImage `json:"backgroundImage,omitempty"`
+	TargetWidth              string           `json:"targetWidth,omitempty"`
+	SelectAction             *Action          `json:"selectAction,omitempty"`
+	Items                    []Element        `json:"items"`
+}
+
+// BackgroundImage is drawn behind a container's items. FillMode is "cover"
+// (the default), "repeatHorizontally", "repeatVertically" or "repeat".
+type BackgroundImage struct {
+	URL                 string `json:"url"`
+	FillMode            string `json:"fillMode,omitempty"`
+	HorizontalAlignment string `json:"horizontalAlignment,omitempty"`
+	VerticalAlignment   string `json:"verticalAlignment,omitempty"`
+}
+
+func NewBackgroundImage(url string) BackgroundImage {
+	return BackgroundImage{URL: url}
 }
 
 func NewContainer(items ...Element) Container {
@@ -77,28 +171,90 @@ func (c Container) toRaw() any {
 	// recursively flatten inner elements
 	items := make([]any, len(c.Items))
 	for i, el := range c.Items {
-		items[i] = el.toRaw()
+		items[i] = rawOf(el)
 	}
 	return struct {
-		Type      string `json:"type"`
-		Separator bool   `json:"separator"`
-		Items     []any  `json:"items"`
+		Type string `json:"type"`
+		BaseElement
+		Style                    ContainerStyle   `json:"style,omitempty"`
+		Bleed                    bool             `json:"bleed,omitempty"`
+		MinHeight                string           `json:"minHeight,omitempty"`
+		VerticalContentAlignment string           `json:"verticalContentAlignment,omitempty"`
+		BackgroundImage          *BackgroundImage `json:"backgroundImage,omitempty"`
+		TargetWidth              string           `json:"targetWidth,omitempty"`
+		SelectAction             *Action          `json:"selectAction,omitempty"`
+		Items                    []any            `json:"items"`
 	}{
-		Type:      "Container",
-		Separator: c.Separator,
-		Items:     items,
+		Type:                     "Container",
+		BaseElement:              c.BaseElement,
+		Style:                    c.Style,
+		Bleed:                    c.Bleed,
+		MinHeight:                c.MinHeight,
+		VerticalContentAlignment: c.VerticalContentAlignment,
+		BackgroundImage:          c.BackgroundImage,
+		TargetWidth:              c.TargetWidth,
+		SelectAction:             c.SelectAction,
+		Items:                    items,
 	}
 }
 
-func (c *Container) WithSeparator() {
-	c.Separator = true
+// WithStyle sets the container style: "default", "emphasis", "good",
+// "attention", "warning" or "accent".
+func (c *Container) WithStyle(style ContainerStyle) {
+	c.Style = style
+}
+
+// WithTargetWidth shows the container only at the given card widths, e.g.
+// "narrow" or "atLeast:standard".
+func (c *Container) WithTargetWidth(targetWidth string) {
+	c.TargetWidth = targetWidth
+}
+
+// WithBleed extends a styled container's background to the edges of its
+// parent, e.g. for a full-width severity banner.
+func (c *Container) WithBleed() {
+	c.Bleed = true
+}
+
+// WithMinHeight sets the minimum height in pixels, e.g. "80px".
+func (c *Container) WithMinHeight(minHeight string) {
+	c.MinHeight = minHeight
+}
+
+// WithVerticalContentAlignment sets "top", "center" or "bottom"; it matters
+// when the container is taller than its items.
+func (c *Container) WithVerticalContentAlignment(alignment string) {
+	c.VerticalContentAlignment = alignment
+}
+
+func (c *Container) WithBackgroundImage(img BackgroundImage) {
+	c.BackgroundImage = &img
+}
+
+// WithSelectAction makes the whole container tappable.
+func (c *Container) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	c.SelectAction = &a
+}
+
+func (c *AdaptiveCard) WithFallbackText(text string) {
+	c.FallbackText = text
+}
+
+func (c *AdaptiveCard) WithSpeak(speak string) {
+	c.Speak = speak
+}
+
+func (c *AdaptiveCard) WithLang(lang string) {
+	c.Lang = lang
 }
 
 // ----------------------
 // FactSet
 // ----------------------
 type FactSet struct {
-	Type  string `json:"type"`
+	Type string `json:"type"`
+	BaseElement
 	Facts []Fact `json:"facts"`
 }
 type Fact struct {
@@ -121,37 +277,59 @@ func (fs FactSet) toRaw() any {
 // Table
 // ----------------------
 type Table struct {
-	Type              string     `json:"type"`
-	Columns           []TableCol `json:"columns"`
-	Rows              []TableRow `json:"rows"`
-	FirstRowAsHeaders bool       `json:"firstRowAsHeaders"`
-	ShowGridLines     bool       `json:"showGridLines"`
+	Type string `json:"type"`
+	BaseElement
+	Columns                        []TableCol     `json:"columns"`
+	Rows                           []TableRow     `json:"rows"`
+	FirstRowAsHeaders              *bool          `json:"firstRowAsHeaders,omitempty"`
+	ShowGridLines                  *bool          `json:"showGridLines,omitempty"`
+	GridStyle                      ContainerStyle `json:"gridStyle,omitempty"`
+	HorizontalCellContentAlignment string         `json:"horizontalCellContentAlignment,omitempty"`
+	VerticalCellContentAlignment   string         `json:"verticalCellContentAlignment,omitempty"`
 }
 
 type TableCol struct {
-	Width int `json:"width"`
+	Width                          int    `json:"width"`
+	HorizontalCellContentAlignment string `json:"horizontalCellContentAlignment,omitempty"`
+	VerticalCellContentAlignment   string `json:"verticalCellContentAlignment,omitempty"`
 }
 
 type TableRow struct {
-	Type  string      `json:"type"`
-	Cells []TableCell `json:"cells"`
+	Type                           string         `json:"type"`
+	Cells                          []TableCell    `json:"cells"`
+	Style                          ContainerStyle `json:"style,omitempty"`
+	HorizontalCellContentAlignment string         `json:"horizontalCellContentAlignment,omitempty"`
+	VerticalCellContentAlignment   string         `json:"verticalCellContentAlignment,omitempty"`
+	// Extra holds row properties the package has no field for; see
+	// BaseElement.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type TableCell struct {
-	Type  string    `json:"type"`
-	Style string    `json:"style"`
-	Items []Element `json:"items"`
+	Type                     string         `json:"type"`
+	Style                    ContainerStyle `json:"style,omitempty"`
+	VerticalContentAlignment string         `json:"verticalContentAlignment,omitempty"`
+	Items                    []Element      `json:"items"`
+	// Extra holds cell properties the package has no field for, e.g.
+	// backgroundImage; see BaseElement.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 func NewTable() Table {
 	return Table{
 		Type:              "Table",
-		FirstRowAsHeaders: true,
-		ShowGridLines:     false,
+		FirstRowAsHeaders: Bool(true),
+		ShowGridLines:     Bool(false),
 		Columns:           []TableCol{},
 		Rows:              []TableRow{},
 	}
 }
+func NewTableRow(cells ...TableCell) TableRow {
+	return TableRow{
+		Type:  "TableRow",
+		Cells: cells,
+	}
+}
 func NewTableCell(items ...Element) TableCell {
 	return TableCell{
 		Type:  "TableCell",
@@ -167,17 +345,55 @@ func (t Table) toRaw() any {
 		rows[i] = r.toRaw()
 	}
 	return struct {
-		Type              string     `json:"type"`
-		Columns           []TableCol `json:"columns"`
-		Rows              []any      `json:"rows"`
-		ShowGridLines     bool       `json:"showGridLines"`
-		FirstRowAsHeaders bool       `json:"firstRowAsHeaders"`
+		Type string `json:"type"`
+		BaseElement
+		Columns                        []TableCol     `json:"columns,omitempty"`
+		Rows                           []any          `json:"rows"`
+		ShowGridLines                  *bool          `json:"showGridLines,omitempty"`
+		FirstRowAsHeaders              *bool          `json:"firstRowAsHeaders,omitempty"`
+		GridStyle                      ContainerStyle `json:"gridStyle,omitempty"`
+		HorizontalCellContentAlignment string         `json:"horizontalCellContentAlignment,omitempty"`
+		VerticalCellContentAlignment   string         `json:"verticalCellContentAlignment,omitempty"`
 	}{
-		Type:              t.Type,
-		Columns:           t.Columns,
-		Rows:              rows,
-		ShowGridLines:     t.ShowGridLines,
-		FirstRowAsHeaders: t.FirstRowAsHeaders,
+		Type:                           t.Type,
+		BaseElement:                    t.BaseElement,
+		Columns:                        t.Columns,
+		Rows:                           rows,
+		ShowGridLines:                  t.ShowGridLines,
+		FirstRowAsHeaders:              t.FirstRowAsHeaders,
+		GridStyle:                      t.GridStyle,
+		HorizontalCellContentAlignment: t.HorizontalCellContentAlignment,
+		VerticalCellContentAlignment:   t.VerticalCellContentAlignment,
+	}
+}
+
+// WithFirstRowAsHeaders sets whether the first row is rendered and announced
+// as column headers. Hosts default to true.
+func (t *Table) WithFirstRowAsHeaders(headers bool) {
+	t.FirstRowAsHeaders = &headers
+}
+
+// WithGridLines sets whether lines are drawn between cells. Hosts default to
+// true; NewTable turns them off.
+func (t *Table) WithGridLines(show bool) {
+	t.ShowGridLines = &show
+}
+
+// WithGridStyle sets the container style of the grid lines, e.g. "accent".
+func (t *Table) WithGridStyle(style ContainerStyle) {
+	t.GridStyle = style
+}
+
+// WithCellContentAlignment sets the default alignment of every cell:
+// horizontal "left", "center" or "right" and vertical "top", "center" or
+// "bottom". Empty values are left unchanged. Columns, rows and cells can
+// override it, in that order.
+func (t *Table) WithCellContentAlignment(horizontal, vertical string) {
+	if horizontal != "" {
+		t.HorizontalCellContentAlignment = horizontal
+	}
+	if vertical != "" {
+		t.VerticalCellContentAlignment = vertical
 	}
 }
 
@@ -186,45 +402,119 @@ func (tr TableRow) toRaw() any {
 	for i, c := range tr.Cells {
 		cells[i] = c.toRaw()
 	}
-	return struct {
-		Type  string `json:"type"`
-		Cells []any  `json:"cells"`
+	return extraOf(struct {
+		Type                           string         `json:"type"`
+		Cells                          []any          `json:"cells"`
+		Style                          ContainerStyle `json:"style,omitempty"`
+		HorizontalCellContentAlignment string         `json:"horizontalCellContentAlignment,omitempty"`
+		VerticalCellContentAlignment   string         `json:"verticalCellContentAlignment,omitempty"`
 	}{
-		Type:  tr.Type,
-		Cells: cells,
-	}
+		Type:                           tr.Type,
+		Cells:                          cells,
+		Style:                          tr.Style,
+		HorizontalCellContentAlignment: tr.HorizontalCellContentAlignment,
+		VerticalCellContentAlignment:   tr.VerticalCellContentAlignment,
+	}, tr.Extra)
+}
+
+// WithStyle sets the container style (e.g. "accent", "emphasis") used as the
+// background of every cell in the row.
+func (tr *TableRow) WithStyle(style ContainerStyle) {
+	tr.Style = style
+}
+
+func (tr *TableRow) WithHorizontalCellContentAlignment(alignment string) {
+	tr.HorizontalCellContentAlignment = alignment
+}
+
+// WithVerticalCellContentAlignment sets "top", "center" or "bottom" for
+// every cell in the row.
+func (tr *TableRow) WithVerticalCellContentAlignment(alignment string) {
+	tr.VerticalCellContentAlignment = alignment
 }
 
 func (tc TableCell) toRaw() any {
 	items := make([]any, len(tc.Items))
 	for i, el := range tc.Items {
-		items[i] = el.toRaw()
+		items[i] = rawOf(el)
 	}
-	return struct {
-		Type  string `json:"type"`
-		Items []any  `json:"items"`
-		Style string `json:"style"`
+	return extraOf(struct {
+		Type                     string         `json:"type"`
+		Items                    []any          `json:"items"`
+		Style                    ContainerStyle `json:"style,omitempty"`
+		VerticalContentAlignment string         `json:"verticalContentAlignment,omitempty"`
 	}{
-		Type:  tc.Type,
-		Style: tc.Style,
-		Items: items,
-	}
+		Type:                     tc.Type,
+		Style:                    tc.Style,
+		VerticalContentAlignment: tc.VerticalContentAlignment,
+		Items:                    items,
+	}, tc.Extra)
+}
+
+// WithStyle sets the cell's background container style.
+func (tc *TableCell) WithStyle(style ContainerStyle) {
+	tc.Style = style
+}
+
+// WithVerticalContentAlignment sets "top", "center" or "bottom".
+func (tc *TableCell) WithVerticalContentAlignment(alignment string) {
+	tc.VerticalContentAlignment = alignment
 }
 
 // ----------------------
 // Action
 // ----------------------
+// Action is the flat form of every action type, kept for compatibility;
+// prefer the typed actions in actions.go.
+//
+// An action is a button or a selectAction. Card is the card revealed by an
+// Action.ShowCard. AssociatedInputs is "auto" (the default) to validate and
+// send the card's inputs with a Submit or Execute action, or "none".
 type Action struct {
-	Type  string `json:"type"`
-	Title string `json:"title"`
-	Url   string `json:"url,omitempty"`
+	Type             string          `json:"type"`
+	Title            string          `json:"title"`
+	Url              string          `json:"url,omitempty"`
+	Verb             string          `json:"verb,omitempty"`
+	Data             any             `json:"data,omitempty"`
+	Mode             string          `json:"mode,omitempty"`
+	AssociatedInputs string          `json:"associatedInputs,omitempty"`
+	TargetElements   []TargetElement `json:"targetElements,omitempty"`
+	Card             *AdaptiveCard   `json:"card,omitempty"`
+	IconURL          string          `json:"iconUrl,omitempty"`
+	// Style is "default", "positive" or "destructive".
+	Style     string `json:"style,omitempty"`
+	Tooltip   string `json:"tooltip,omitempty"`
+	IsEnabled *bool  `json:"isEnabled,omitempty"`
+	// Extra holds action properties the package has no field for; see
+	// BaseElement.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TargetElement is an element toggled by an Action.ToggleVisibility. A nil
+// IsVisible flips the element's current visibility.
+type TargetElement struct {
+	ElementID string `json:"elementId"`
+	IsVisible *bool  `json:"isVisible,omitempty"`
+}
+
+func NewToggleVisibilityAction(title string, elementIDs ...string) ToggleVisibilityAction {
+	targets := make([]TargetElement, len(elementIDs))
+	for i, id := range elementIDs {
+		targets[i] = TargetElement{ElementID: id}
+	}
+	return ToggleVisibilityAction{
+		Type:           "Action.ToggleVisibility",
+		Title:          title,
+		TargetElements: targets,
+	}
 }
 
 // ----------------------
 // MSTeams
 // ----------------------
 type MSTeamsInfo struct {
-	Entities []MSTeamsEntity `json:"entities"`
+	Width    string          `json:"width,omitempty"`
+	Entities []MSTeamsEntity `json:"entities,omitempty"`
 }
 type MSTeamsEntity struct {
 	Type      string  `json:"type"`
@@ -235,6 +525,11 @@ type MSTeamsEntity struct {
 type Mention struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	// ConversationIdentityType is "channel" or "team" when the mention is
+	// of a conversation rather than a user.
+	ConversationIdentityType string `json:"conversationIdentityType,omitempty"`
+	// Type is "tag" for a tag mention and empty otherwise.
+	Type string `json:"type,omitempty"`
 }
 
 // ----------------------
@@ -244,10 +539,16 @@ func (c *AdaptiveCard) AddBody(el Element) {
 	c.Body = append(c.Body, el)
 }
 
-func (c *AdaptiveCard) AddAction(action Action) {
+func (c *AdaptiveCard) AddAction(action ActionElement) {
 	c.Actions = append(c.Actions, action)
 }
 
+// WithSelectAction makes the whole card tappable, e.g. to open a dashboard.
+func (c *AdaptiveCard) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	c.SelectAction = &a
+}
+
 func (c *Container) AddItem(el Element) {
 	c.Items = append(c.Items, el)
 }
@@ -257,9 +558,36 @@ func (t *Table) AddColumn(width int) {
 }
 
 func (t *Table) AddRow(cells ...TableCell) {
-	t.Rows = append(t.Rows, TableRow{Type: "TableRow", Cells: cells})
+	t.Rows = append(t.Rows, NewTableRow(cells...))
+}
+
+// AddTableRow appends a row built with NewTableRow, for rows that need their
+// own style or alignment.
+func (t *Table) AddTableRow(row TableRow) {
+	t.Rows = append(t.Rows, row)
+}
+
+// AddHeaderRow makes a row of bold TextBlock cells the table's first row and
+// sets FirstRowAsHeaders. Columns of width 1 are added if the table has
+// fewer than len(titles).
+func (t *Table) AddHeaderRow(titles ...string) {
+	cells := make([]TableCell, len(titles))
+	for i, title := range titles {
+		tb := NewTextBlock(title)
+		tb.WithWeight(WeightBolder)
+		cells[i] = NewTableCell(tb)
+	}
+	for len(t.Columns) < len(titles) {
+		t.AddColumn(1)
+	}
+	t.Rows = append([]TableRow{NewTableRow(cells...)}, t.Rows...)
+	t.WithFirstRowAsHeaders(true)
 }
 
+// AddMentionsMap appends a TextBlock of placeholders for mentions but always
+// adds a single "@Team" entity, so the placeholders do not resolve.
+//
+// Deprecated: use AddUserMention or MentionAll, which add an entity per user.
 func (c *AdaptiveCard) AddMentionsMap(textPrefix string, mentions []string) {
 	if c.MSTeams == nil {
 		c.MSTeams = &MSTeamsInfo{
@@ -291,27 +619,68 @@ func (c *AdaptiveCard) AddMentionsMap(textPrefix string, mentions []string) {
 // MarshalJSON for AdaptiveCard
 // ----------------------
 func (c AdaptiveCard) MarshalJSON() ([]byte, error) {
+	raw, err := c.raw()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(extraOf(raw, raw.extra))
+}
+
+// cardJSON is the serialized form of a card.
+type cardJSON struct {
+	Type           string          `json:"type"`
+	Version        string          `json:"version"`
+	Body           []any           `json:"body"`
+	Schema         string          `json:"$schema"`
+	Actions        []any           `json:"actions,omitempty"`
+	MSTeams        *MSTeamsInfo    `json:"msteams,omitempty"`
+	Refresh        *Refresh        `json:"refresh,omitempty"`
+	Authentication *Authentication `json:"authentication,omitempty"`
+	SelectAction   *Action         `json:"selectAction,omitempty"`
+	FallbackText   string          `json:"fallbackText,omitempty"`
+	Speak          string          `json:"speak,omitempty"`
+	Lang           string          `json:"lang,omitempty"`
+
+	extra map[string]json.RawMessage
+}
+
+// raw applies middleware and validation and returns the value to serialize.
+func (c AdaptiveCard) raw() (cardJSON, error) {
+	c, err := c.applyMiddleware()
+	if err != nil {
+		return cardJSON{}, err
+	}
+	c.Body = resolveConditionals(c.Body)
+
 	body := make([]any, len(c.Body))
 	for i, el := range c.Body {
-		body[i] = el.toRaw()
+		body[i] = rawOf(el)
 	}
 
-	// build a raw struct to marshal
-	raw := struct {
-		Type    string       `json:"type"`
-		Version string       `json:"version"`
-		Body    []any        `json:"body"`
-		Schema  string       `json:"$schema"`
-		Actions []Action     `json:"actions,omitempty"`
-		MSTeams *MSTeamsInfo `json:"msteams,omitempty"`
-	}{
-		Type:    c.Type,
-		Version: c.Version,
-		Body:    body,
-		Schema:  c.Schema,
-		Actions: c.Actions,
-		MSTeams: c.MSTeams,
+	actions := make([]any, len(c.Actions))
+	for i, a := range c.Actions {
+		if r, ok := a.(RawAction); ok {
+			actions[i] = r.JSON
+		} else {
+			actions[i] = a.flat()
+		}
 	}
 
-	return json.Marshal(raw)
+	// build a raw struct to marshal
+	raw := cardJSON{
+		Type:           c.Type,
+		Version:        c.Version,
+		Body:           body,
+		Schema:         c.Schema,
+		Actions:        actions,
+		MSTeams:        c.MSTeams,
+		Refresh:        c.Refresh,
+		Authentication: c.Authentication,
+		SelectAction:   c.SelectAction,
+		FallbackText:   c.FallbackText,
+		Speak:          c.Speak,
+		Lang:           c.Lang,
+		extra:          c.Extra,
+	}
+	return raw, nil
 }
@@ -0,0 +1,68 @@
+package adaptivecard
+
+// ----------------------
+// Badge
+// ----------------------
+type Badge struct {
+	Type string `json:"type"`
+	BaseElement
+	Text         string   `json:"text,omitempty"`
+	Icon         IconName `json:"icon,omitempty"`
+	IconPosition string   `json:"iconPosition,omitempty"`
+	Appearance   string   `json:"appearance,omitempty"`
+	Size         string   `json:"size,omitempty"`
+	Shape        string   `json:"shape,omitempty"`
+	Style        string   `json:"style,omitempty"`
+	Tooltip      string   `json:"tooltip,omitempty"`
+}
+
+func NewBadge(text string) Badge {
+	return Badge{
+		Type: "Badge",
+		Text: text,
+	}
+}
+func (Badge) isElement() {}
+func (b Badge) toRaw() any {
+	return b
+}
+
+// WithIcon shows an icon next to the text; position is "Before" or "After".
+func (b *Badge) WithIcon(icon IconName, position string) {
+	b.Icon = icon
+	b.IconPosition = position
+}
+
+// WithAppearance sets "Filled" or "Tint".
+func (b *Badge) WithAppearance(appearance string) {
+	b.Appearance = appearance
+}
+
+// WithSize sets "Medium", "Large" or "ExtraLarge".
+func (b *Badge) WithSize(size string) {
+	b.Size = size
+}
+
+func (b *Badge) WithShape(shape string) {
+	b.Shape = shape
+}
+
+func (b *Badge) WithStyle(style string) {
+	b.Style = style
+}
+
+func (b *Badge) WithTooltip(tooltip string) {
+	b.Tooltip = tooltip
+}
+
+// SeverityBadge returns a badge for an alert severity, styled consistently
+// with the other Severity mappings. Higher severities use a filled appearance
+// so they stand out from the rest.
+func SeverityBadge(level Severity) Badge {
+	level = ParseSeverity(string(level))
+	b := NewBadge(level.Label())
+	b.WithStyle(level.BadgeStyle())
+	b.WithAppearance(level.BadgeAppearance())
+	b.WithIcon(level.Icon(), "Before")
+	return b
+}
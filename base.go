@@ -0,0 +1,131 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ----------------------
+// BaseElement
+// ----------------------
+
+// BaseElement holds the properties every element supports. It is embedded in
+// each element type, so its fields are promoted and serialize inline.
+type BaseElement struct {
+	ID string `json:"id,omitempty"`
+	// Spacing is the gap above the element: "none", "small", "default",
+	// "medium", "large", "extraLarge" or "padding".
+	Spacing   Spacing `json:"spacing,omitempty"`
+	Separator *bool   `json:"separator,omitempty"`
+	// Height is "auto" or "stretch".
+	Height    string    `json:"height,omitempty"`
+	IsVisible *bool     `json:"isVisible,omitempty"`
+	Fallback  *Fallback `json:"fallback,omitempty"`
+	// Requires maps host capabilities to the minimum version the element
+	// needs, e.g. {"adaptiveCards": "1.5"}; hosts that fall short render
+	// the fallback instead.
+	Requires map[string]string `json:"requires,omitempty"`
+	// Extra holds properties the package has no field for. Parsing fills
+	// it with unrecognized keys, and marshaling emits it after the known
+	// properties, so third-party cards survive an edit round trip.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Fallback is what a host renders in place of an element it does not
+// support: nothing when Drop is set, Element otherwise.
+type Fallback struct {
+	Drop    bool
+	Element Element
+}
+
+func (f Fallback) MarshalJSON() ([]byte, error) {
+	if f.Drop || f.Element == nil {
+		return json.Marshal("drop")
+	}
+	return json.Marshal(rawOf(f.Element))
+}
+
+func (b *BaseElement) WithID(id string) {
+	b.ID = id
+}
+
+func (b *BaseElement) WithSpacing(spacing Spacing) {
+	b.Spacing = spacing
+}
+
+func (b *BaseElement) WithSeparator() {
+	b.Separator = Bool(true)
+}
+
+func (b *BaseElement) WithHeight(height string) {
+	b.Height = height
+}
+
+// WithVisible sets the initial visibility, typically toggled later by an
+// Action.ToggleVisibility.
+func (b *BaseElement) WithVisible(visible bool) {
+	b.IsVisible = &visible
+}
+
+// WithFallback sets the element shown instead on hosts that cannot render
+// this one, e.g. a TextBlock summary for a Table. The fallback may itself
+// have a fallback.
+func (b *BaseElement) WithFallback(el Element) {
+	b.Fallback = &Fallback{Element: el}
+}
+
+// WithFallbackDrop drops the element on hosts that cannot render it instead
+// of failing the whole card.
+func (b *BaseElement) WithFallbackDrop() {
+	b.Fallback = &Fallback{Drop: true}
+}
+
+// WithRequires declares that the element needs version of a host capability;
+// pair it with WithFallback.
+func (b *BaseElement) WithRequires(capability, version string) {
+	if b.Requires == nil {
+		b.Requires = map[string]string{}
+	}
+	b.Requires[capability] = version
+}
+
+// Bool returns a pointer to v, for the optional boolean properties where
+// false, true and unset mean different things, e.g. TextBlock.Wrap.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// boolOr returns *p, or def when p is unset.
+func boolOr(p *bool, def bool) bool {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+func (b BaseElement) base() BaseElement {
+	return b
+}
+
+// baseOf returns the BaseElement embedded in el.
+func baseOf(el Element) (BaseElement, bool) {
+	if b, ok := el.(interface{ base() BaseElement }); ok {
+		return b.base(), true
+	}
+	return BaseElement{}, false
+}
+
+// withBase returns a copy of el with its embedded BaseElement replaced by b.
+func withBase(el Element, b BaseElement) Element {
+	if reflect.TypeOf(el).Kind() != reflect.Struct {
+		return el
+	}
+	v := reflect.New(reflect.TypeOf(el)).Elem()
+	v.Set(reflect.ValueOf(el))
+	field := v.FieldByName("BaseElement")
+	if !field.IsValid() {
+		return el
+	}
+	field.Set(reflect.ValueOf(b))
+	return v.Interface().(Element)
+}
@@ -0,0 +1,210 @@
+package adaptivecard
+
+// The builders wrap the With setters in methods that return the builder, so
+// elements can be configured in one expression:
+//
+//	card := adaptivecard.NewCardBuilder("1.5").
+//		Add(adaptivecard.NewTextBlockBuilder("Deploy failed").Bold().Large().Build()).
+//		Action(adaptivecard.NewOpenUrlAction("View run", runURL)).
+//		Build()
+//
+// Build returns the configured value; the builder can keep being used
+// afterwards without affecting it.
+
+// TextBlockBuilder builds a TextBlock with chained calls.
+type TextBlockBuilder struct {
+	tb TextBlock
+}
+
+func NewTextBlockBuilder(text string) *TextBlockBuilder {
+	return &TextBlockBuilder{tb: NewTextBlock(text)}
+}
+
+func (b *TextBlockBuilder) ID(id string) *TextBlockBuilder {
+	b.tb.WithID(id)
+	return b
+}
+
+func (b *TextBlockBuilder) Spacing(spacing Spacing) *TextBlockBuilder {
+	b.tb.WithSpacing(spacing)
+	return b
+}
+
+func (b *TextBlockBuilder) Separator() *TextBlockBuilder {
+	b.tb.WithSeparator()
+	return b
+}
+
+func (b *TextBlockBuilder) Weight(weight Weight) *TextBlockBuilder {
+	b.tb.WithWeight(weight)
+	return b
+}
+
+// Bold is Weight(WeightBolder).
+func (b *TextBlockBuilder) Bold() *TextBlockBuilder {
+	return b.Weight(WeightBolder)
+}
+
+func (b *TextBlockBuilder) Size(size Size) *TextBlockBuilder {
+	b.tb.WithSize(size)
+	return b
+}
+
+// Large is Size(SizeLarge).
+func (b *TextBlockBuilder) Large() *TextBlockBuilder {
+	return b.Size(SizeLarge)
+}
+
+func (b *TextBlockBuilder) Color(color Color) *TextBlockBuilder {
+	b.tb.WithColor(color)
+	return b
+}
+
+func (b *TextBlockBuilder) Subtle() *TextBlockBuilder {
+	b.tb.WithSubtle()
+	return b
+}
+
+func (b *TextBlockBuilder) Heading() *TextBlockBuilder {
+	b.tb.WithHeading()
+	return b
+}
+
+// Monospace sets font type "monospace".
+func (b *TextBlockBuilder) Monospace() *TextBlockBuilder {
+	b.tb.WithFontType("monospace")
+	return b
+}
+
+func (b *TextBlockBuilder) Align(alignment string) *TextBlockBuilder {
+	b.tb.WithHorizontalAlignment(alignment)
+	return b
+}
+
+func (b *TextBlockBuilder) MaxLines(n int) *TextBlockBuilder {
+	b.tb.WithMaxLines(n)
+	return b
+}
+
+func (b *TextBlockBuilder) Wrap(wrap bool) *TextBlockBuilder {
+	b.tb.WithWrap(wrap)
+	return b
+}
+
+func (b *TextBlockBuilder) Build() TextBlock {
+	return b.tb
+}
+
+// ContainerBuilder builds a Container with chained calls.
+type ContainerBuilder struct {
+	c Container
+}
+
+func NewContainerBuilder(items ...Element) *ContainerBuilder {
+	return &ContainerBuilder{c: NewContainer(items...)}
+}
+
+func (b *ContainerBuilder) ID(id string) *ContainerBuilder {
+	b.c.WithID(id)
+	return b
+}
+
+func (b *ContainerBuilder) Spacing(spacing Spacing) *ContainerBuilder {
+	b.c.WithSpacing(spacing)
+	return b
+}
+
+func (b *ContainerBuilder) Separator() *ContainerBuilder {
+	b.c.WithSeparator()
+	return b
+}
+
+func (b *ContainerBuilder) Style(style ContainerStyle) *ContainerBuilder {
+	b.c.WithStyle(style)
+	return b
+}
+
+func (b *ContainerBuilder) Bleed() *ContainerBuilder {
+	b.c.WithBleed()
+	return b
+}
+
+func (b *ContainerBuilder) SelectAction(action ActionElement) *ContainerBuilder {
+	b.c.WithSelectAction(action)
+	return b
+}
+
+func (b *ContainerBuilder) Add(items ...Element) *ContainerBuilder {
+	for _, item := range items {
+		b.c.AddItem(item)
+	}
+	return b
+}
+
+func (b *ContainerBuilder) Build() Container {
+	b.c.Items = append([]Element(nil), b.c.Items...)
+	return b.c
+}
+
+// CardBuilder builds an AdaptiveCard with chained calls.
+type CardBuilder struct {
+	card AdaptiveCard
+}
+
+// NewCardBuilder starts a card like NewAdaptiveCard.
+func NewCardBuilder(version string) *CardBuilder {
+	return &CardBuilder{card: NewAdaptiveCard(version)}
+}
+
+func (b *CardBuilder) Add(elements ...Element) *CardBuilder {
+	for _, el := range elements {
+		b.card.AddBody(el)
+	}
+	return b
+}
+
+// AddIf is AddBodyIf.
+func (b *CardBuilder) AddIf(cond bool, el Element) *CardBuilder {
+	b.card.AddBodyIf(cond, el)
+	return b
+}
+
+func (b *CardBuilder) Action(actions ...ActionElement) *CardBuilder {
+	for _, a := range actions {
+		b.card.AddAction(a)
+	}
+	return b
+}
+
+func (b *CardBuilder) SelectAction(action ActionElement) *CardBuilder {
+	b.card.WithSelectAction(action)
+	return b
+}
+
+func (b *CardBuilder) FallbackText(text string) *CardBuilder {
+	b.card.WithFallbackText(text)
+	return b
+}
+
+func (b *CardBuilder) Lang(lang string) *CardBuilder {
+	b.card.WithLang(lang)
+	return b
+}
+
+// FullWidth is SetFullWidth.
+func (b *CardBuilder) FullWidth() *CardBuilder {
+	b.card.SetFullWidth()
+	return b
+}
+
+func (b *CardBuilder) Use(middleware ...Middleware) *CardBuilder {
+	b.card.Use(middleware...)
+	return b
+}
+
+func (b *CardBuilder) Build() AdaptiveCard {
+	card := b.card
+	card.Body = append([]Element(nil), card.Body...)
+	card.Actions = append([]ActionElement(nil), card.Actions...)
+	return card
+}
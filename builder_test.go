@@ -0,0 +1,17 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewCardBuilderSetsSchema(t *testing.T) {
+	for name, card := range map[string]AdaptiveCard{
+		"NewAdaptiveCard": NewAdaptiveCard("1.5"),
+		"NewCardBuilder":  NewCardBuilder("1.5").Build(),
+	} {
+		if got := mustMarshal(t, card); !strings.Contains(got, `"$schema":"`+SchemaURL+`"`) {
+			t.Errorf("%s: $schema not set in %s", name, got)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package adaptivecard
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrCardNotFound is returned by CardStore.Load for unknown message IDs.
+var ErrCardNotFound = errors.New("adaptivecard: card not found")
+
+// CardStore keeps the cards a bot has sent, keyed by the message (activity)
+// ID, so the original card can be looked up when handling a refresh or
+// Action.Execute invoke for that message (InvokeActivity.ReplyToID).
+type CardStore interface {
+	Save(ctx context.Context, messageID string, card AdaptiveCard) error
+	Load(ctx context.Context, messageID string) (AdaptiveCard, error)
+}
+
+// MemoryCardStore is an in-process CardStore. Cards are stored as given, so
+// callers must not modify a card's elements after saving it.
+type MemoryCardStore struct {
+	mu    sync.RWMutex
+	cards map[string]AdaptiveCard
+}
+
+func NewMemoryCardStore() *MemoryCardStore {
+	return &MemoryCardStore{cards: map[string]AdaptiveCard{}}
+}
+
+func (s *MemoryCardStore) Save(_ context.Context, messageID string, card AdaptiveCard) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cards[messageID] = card
+	return nil
+}
+
+func (s *MemoryCardStore) Load(_ context.Context, messageID string) (AdaptiveCard, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	card, ok := s.cards[messageID]
+	if !ok {
+		return AdaptiveCard{}, ErrCardNotFound
+	}
+	return card, nil
+}
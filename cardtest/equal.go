@@ -0,0 +1,132 @@
+package cardtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Equal compares two cards structurally. a and b may be cards (or any other
+// JSON-marshalable value) or raw JSON as []byte / json.RawMessage. Key order,
+// formatting, and the difference between empty, null and missing arrays or
+// objects are ignored. When the cards differ, diff lists every mismatch with
+// the JSON path of the element, one per line.
+func Equal(a, b any) (equal bool, diff string) {
+	va, err := decode(a)
+	if err != nil {
+		return false, fmt.Sprintf("$: left side: %v", err)
+	}
+	vb, err := decode(b)
+	if err != nil {
+		return false, fmt.Sprintf("$: right side: %v", err)
+	}
+
+	var diffs []string
+	compare("$", prune(va), prune(vb), &diffs)
+	return len(diffs) == 0, strings.Join(diffs, "\n")
+}
+
+func decode(v any) (any, error) {
+	var data []byte
+	switch v := v.(type) {
+	case []byte:
+		data = v
+	case json.RawMessage:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// prune drops null values and empty arrays and objects, recursively.
+func prune(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if child = prune(child); child == nil {
+				delete(v, k)
+			} else {
+				v[k] = child
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		for i, child := range v {
+			v[i] = prune(child)
+		}
+		return v
+	}
+	return v
+}
+
+func compare(path string, a, b any, diffs *[]string) {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make(map[string]bool)
+		for k := range av {
+			keys[k] = true
+		}
+		for k := range bv {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			compare(path+"."+k, av[k], bv[k], diffs)
+		}
+		return
+	case []any:
+		bv, ok := b.([]any)
+		if !ok {
+			break
+		}
+		if len(av) != len(bv) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: length %d != %d", path, len(av), len(bv)))
+		}
+		for i := 0; i < min(len(av), len(bv)); i++ {
+			compare(fmt.Sprintf("%s[%d]", path, i), av[i], bv[i], diffs)
+		}
+		return
+	}
+
+	if a != b {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s != %s", path, describe(a), describe(b)))
+	}
+}
+
+func describe(v any) string {
+	if v == nil {
+		return "<missing>"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,74 @@
+package cardtest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  any
+		equal bool
+		diff  string
+	}{
+		{
+			name:  "key order and formatting",
+			a:     []byte(`{"type":"AdaptiveCard","version":"1.5"}`),
+			b:     json.RawMessage("{\n  \"version\": \"1.5\",\n  \"type\": \"AdaptiveCard\"\n}"),
+			equal: true,
+		},
+		{
+			name:  "empty, null and missing",
+			a:     []byte(`{"type":"AdaptiveCard","body":[],"actions":null,"msteams":{}}`),
+			b:     []byte(`{"type":"AdaptiveCard"}`),
+			equal: true,
+		},
+		{
+			name:  "card against JSON",
+			a:     alertCard("Disk almost full"),
+			b:     []byte(`{"$schema":"http://adaptivecards.io/schemas/adaptive-card.json","type":"AdaptiveCard","version":"1.5","body":[{"type":"TextBlock","text":"Disk almost full","weight":"bolder","wrap":true}]}`),
+			equal: true,
+		},
+		{
+			name: "changed values",
+			a:    alertCard("Disk almost full"),
+			b:    alertCard("Disk full"),
+			diff: `$.body[0].text: "Disk almost full" != "Disk full"`,
+		},
+		{
+			name: "missing key and length",
+			a:    []byte(`{"body":[{"type":"TextBlock","id":"a"},{"type":"Image"}]}`),
+			b:    []byte(`{"body":[{"type":"TextBlock"}]}`),
+			diff: "$.body: length 2 != 1\n$.body[0].id: \"a\" != <missing>",
+		},
+		{
+			name: "type mismatch",
+			a:    []byte(`{"body":{"type":"TextBlock"}}`),
+			b:    []byte(`{"body":[{"type":"TextBlock"}]}`),
+			diff: `$.body: {"type":"TextBlock"} != [{"type":"TextBlock"}]`,
+		},
+		{
+			name: "numbers compare exactly",
+			a:    []byte(`{"maxLines":1}`),
+			b:    []byte(`{"maxLines":1.0}`),
+			diff: `$.maxLines: 1 != 1.0`,
+		},
+		{
+			name: "invalid JSON",
+			a:    []byte(`{`),
+			b:    adaptivecard.NewAdaptiveCard("1.5"),
+			diff: "$: left side: unexpected EOF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diff := Equal(tt.a, tt.b)
+			if equal != tt.equal || diff != tt.diff {
+				t.Errorf("Equal() = %v, %q; want %v, %q", equal, diff, tt.equal, tt.diff)
+			}
+		})
+	}
+}
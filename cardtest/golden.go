@@ -0,0 +1,93 @@
+// Package cardtest provides helpers for testing code that builds Adaptive
+// Cards: golden-file snapshots and structural comparison.
+package cardtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update is set by running the tests with -cardtest.update. The prefix keeps
+// it from clashing with an -update flag defined by the package under test.
+var update = flag.Bool("cardtest.update", false, "rewrite cardtest golden files with the current output")
+
+// AssertMatchesGolden marshals card (an adaptivecard.AdaptiveCard or any other
+// JSON value) and compares it with the golden file at path. Both sides are
+// normalised first, so key order and whitespace do not matter. Run the tests
+// with -cardtest.update to create or rewrite the golden file.
+func AssertMatchesGolden(t testing.TB, card any, path string) {
+	t.Helper()
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		t.Fatalf("cardtest: marshaling card: %v", err)
+	}
+	got, err := Normalize(data)
+	if err != nil {
+		t.Fatalf("cardtest: normalising card: %v", err)
+	}
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("cardtest: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("cardtest: writing golden file: %v", err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("cardtest: reading golden file (run with -cardtest.update to create it): %v", err)
+	}
+	want, err := Normalize(golden)
+	if err != nil {
+		t.Fatalf("cardtest: normalising golden file %s: %v", path, err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("cardtest: card does not match %s (run with -cardtest.update to accept):\n%s", path, lineDiff(want, got))
+	}
+}
+
+// Normalize re-encodes JSON with sorted object keys and two-space
+// indentation, so semantically equal documents compare byte for byte.
+func Normalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// lineDiff describes the first line at which want and got differ.
+func lineDiff(want, got []byte) string {
+	wl := strings.Split(string(want), "\n")
+	gl := strings.Split(string(got), "\n")
+	for i := 0; i < max(len(wl), len(gl)); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n- %s\n+ %s", i+1, w, g)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package cardtest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// A package under test may define its own -update flag next to cardtest's.
+var _ = flag.Bool("update", false, "flag of the package under test")
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+}
+
+func alertCard(text string) adaptivecard.AdaptiveCard {
+	card := adaptivecard.NewAdaptiveCard("1.5")
+	tb := adaptivecard.NewTextBlock(text)
+	tb.WithWeight(adaptivecard.WeightBolder)
+	card.AddBody(tb)
+	return card
+}
+
+func TestAssertMatchesGolden(t *testing.T) {
+	AssertMatchesGolden(t, alertCard("Disk almost full"), filepath.Join("testdata", "alert.json"))
+}
+
+func TestAssertMatchesGoldenReportsDifference(t *testing.T) {
+	r := &recorder{TB: t}
+	AssertMatchesGolden(r, alertCard("Disk full"), filepath.Join("testdata", "alert.json"))
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], `"text": "Disk full"`) {
+		t.Errorf("errors = %q, want one naming the changed line", r.errors)
+	}
+}
+
+func TestAssertMatchesGoldenUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "alert.json")
+	*update = true
+	t.Cleanup(func() { *update = false })
+	AssertMatchesGolden(t, alertCard("Disk almost full"), path)
+	*update = false
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "alert.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("written golden file:\n%s\nwant:\n%s", got, want)
+	}
+	AssertMatchesGolden(t, alertCard("Disk almost full"), path)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name, a, b string
+		equal      bool
+	}{
+		{"key order", `{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{"whitespace", `{"a":[1,2]}`, "{\n  \"a\": [ 1, 2 ]\n}", true},
+		{"number precision", `{"a":1.0000000000000001}`, `{"a":1}`, false},
+		{"array order", `[1,2]`, `[2,1]`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Normalize([]byte(tt.a))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := Normalize([]byte(tt.b))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (string(a) == string(b)) != tt.equal {
+				t.Errorf("Normalize(%s) == Normalize(%s) is %v, want %v", tt.a, tt.b, !tt.equal, tt.equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package cardtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// Envelope is the message body Teams webhooks receive.
+type Envelope struct {
+	Type        string               `json:"type"`
+	Attachments []EnvelopeAttachment `json:"attachments"`
+}
+
+type EnvelopeAttachment struct {
+	ContentType string          `json:"contentType"`
+	ContentURL  *string         `json:"contentUrl"`
+	Content     json.RawMessage `json:"content"`
+}
+
+// WebhookRequest is a request recorded by a WebhookServer.
+type WebhookRequest struct {
+	Header   http.Header
+	Body     []byte
+	Envelope Envelope
+	// Status is the status code the server answered with.
+	Status int
+}
+
+// WebhookServer is a fake Teams incoming webhook built on httptest. It records
+// every request and can be told to fail or stall, so webhook client code can
+// be tested without reaching Teams. Close it when done.
+type WebhookServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	requests   []WebhookRequest
+	failures   []int
+	retryAfter time.Duration
+	delay      time.Duration
+}
+
+// NewWebhookServer starts a fake webhook that accepts every request with
+// "200 1", like Teams connectors do.
+func NewWebhookServer() *WebhookServer {
+	s := &WebhookServer{retryAfter: time.Second}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *WebhookServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	delay := s.delay
+	status := http.StatusOK
+	if len(s.failures) > 0 {
+		status, s.failures = s.failures[0], s.failures[1:]
+	}
+	retryAfter := s.retryAfter
+	req := WebhookRequest{Header: r.Header.Clone(), Body: body, Status: status}
+	_ = json.Unmarshal(body, &req.Envelope)
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	switch {
+	case status == http.StatusTooManyRequests:
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		w.WriteHeader(status)
+		io.WriteString(w, "Microsoft Teams endpoint returned HTTP error 429")
+	case status >= 400:
+		w.WriteHeader(status)
+		io.WriteString(w, http.StatusText(status))
+	default:
+		w.WriteHeader(status)
+		io.WriteString(w, "1")
+	}
+}
+
+// FailNext makes the next n requests fail with status. 429 responses carry a
+// Retry-After header (see SetRetryAfter).
+func (s *WebhookServer) FailNext(status, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range n {
+		s.failures = append(s.failures, status)
+	}
+}
+
+// SetRetryAfter sets the Retry-After sent with simulated 429 responses.
+func (s *WebhookServer) SetRetryAfter(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retryAfter = d
+}
+
+// SetDelay makes the server wait d before answering, to exercise client
+// timeouts. The wait ends early if the client gives up.
+func (s *WebhookServer) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = d
+}
+
+// Requests returns the requests received so far.
+func (s *WebhookServer) Requests() []WebhookRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]WebhookRequest(nil), s.requests...)
+}
+
+// Cards parses the card attachments received by requests that were answered
+// successfully, in order. It fails on the first attachment ParseCard rejects;
+// RawCards returns the attachments as sent.
+func (s *WebhookServer) Cards() ([]adaptivecard.AdaptiveCard, error) {
+	var cards []adaptivecard.AdaptiveCard
+	for i, raw := range s.RawCards() {
+		card, err := adaptivecard.ParseCard(raw)
+		if err != nil {
+			return nil, fmt.Errorf("cardtest: card %d: %w", i, err)
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// RawCards returns the content of every card attachment received by requests
+// that were answered successfully, in order.
+func (s *WebhookServer) RawCards() []json.RawMessage {
+	var cards []json.RawMessage
+	for _, r := range s.Requests() {
+		if r.Status >= 400 {
+			continue
+		}
+		for _, a := range r.Envelope.Attachments {
+			cards = append(cards, a.Content)
+		}
+	}
+	return cards
+}
+
+// Reset forgets recorded requests and pending failures.
+func (s *WebhookServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.failures = nil
+	s.delay = 0
+}
@@ -0,0 +1,158 @@
+package cardtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func post(t *testing.T, url string, body []byte) (*http.Response, string) {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	text, _ := io.ReadAll(resp.Body)
+	return resp, string(text)
+}
+
+func envelope(t *testing.T, contents ...string) []byte {
+	t.Helper()
+	env := Envelope{Type: "message"}
+	for _, c := range contents {
+		env.Attachments = append(env.Attachments, EnvelopeAttachment{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     json.RawMessage(c),
+		})
+	}
+	data, err := json.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestWebhookServerRecordsRequests(t *testing.T) {
+	s := NewWebhookServer()
+	defer s.Close()
+
+	card, err := json.Marshal(alertCard("Disk almost full"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, body := post(t, s.URL, envelope(t, string(card)))
+	if resp.StatusCode != http.StatusOK || body != "1" {
+		t.Fatalf("response = %d %q, want 200 \"1\"", resp.StatusCode, body)
+	}
+
+	reqs := s.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d requests, want 1", len(reqs))
+	}
+	if got := reqs[0].Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if reqs[0].Status != http.StatusOK || reqs[0].Envelope.Type != "message" || len(reqs[0].Envelope.Attachments) != 1 {
+		t.Errorf("request = %+v", reqs[0])
+	}
+
+	cards, err := s.Cards()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cards) != 1 {
+		t.Fatalf("got %d cards, want 1", len(cards))
+	}
+	if equal, diff := Equal(cards[0], alertCard("Disk almost full")); !equal {
+		t.Errorf("parsed card differs:\n%s", diff)
+	}
+	if raw := s.RawCards(); len(raw) != 1 || string(raw[0]) != string(card) {
+		t.Errorf("RawCards() = %s, want [%s]", raw, card)
+	}
+}
+
+func TestWebhookServerFailNext(t *testing.T) {
+	s := NewWebhookServer()
+	defer s.Close()
+	s.FailNext(http.StatusTooManyRequests, 1)
+	s.FailNext(http.StatusNotFound, 1)
+	s.SetRetryAfter(3 * time.Second)
+
+	card := `{"type":"AdaptiveCard","version":"1.5"}`
+	resp, _ := post(t, s.URL, envelope(t, card))
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "3" {
+		t.Errorf("first response = %d, Retry-After %q; want 429, 3", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+	resp, body := post(t, s.URL, envelope(t, card))
+	if resp.StatusCode != http.StatusNotFound || body != "Not Found" {
+		t.Errorf("second response = %d %q, want 404", resp.StatusCode, body)
+	}
+	if resp, _ := post(t, s.URL, envelope(t, card)); resp.StatusCode != http.StatusOK {
+		t.Errorf("third response = %d, want 200", resp.StatusCode)
+	}
+
+	if n := len(s.Requests()); n != 3 {
+		t.Errorf("got %d requests, want 3", n)
+	}
+	// Only the accepted request delivered a card.
+	if cards, err := s.Cards(); err != nil || len(cards) != 1 {
+		t.Errorf("Cards() = %d cards, %v; want 1", len(cards), err)
+	}
+}
+
+func TestWebhookServerCardsRejectsInvalidCard(t *testing.T) {
+	s := NewWebhookServer()
+	defer s.Close()
+	post(t, s.URL, envelope(t, `{"type":"AdaptiveCard","version":"1.5"}`, `{"type":"AdaptiveCard","body":"text"}`))
+
+	if _, err := s.Cards(); err == nil {
+		t.Error("Cards() accepted a body that is not an array")
+	}
+	if n := len(s.RawCards()); n != 2 {
+		t.Errorf("RawCards() returned %d cards, want 2", n)
+	}
+}
+
+func TestWebhookServerDelay(t *testing.T) {
+	s := NewWebhookServer()
+	defer s.Close()
+	s.SetDelay(time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(envelope(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = http.DefaultClient.Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("request took %v", elapsed)
+	}
+}
+
+func TestWebhookServerReset(t *testing.T) {
+	s := NewWebhookServer()
+	defer s.Close()
+	s.FailNext(http.StatusInternalServerError, 2)
+	s.SetDelay(time.Minute)
+	s.Reset()
+
+	resp, _ := post(t, s.URL, envelope(t))
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status after Reset = %d, want 200", resp.StatusCode)
+	}
+	s.Reset()
+	if n := len(s.Requests()); n != 0 {
+		t.Errorf("got %d requests after Reset, want 0", n)
+	}
+}
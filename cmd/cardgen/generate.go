@@ -0,0 +1,604 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generator emits Go statements that rebuild a card.
+type generator struct {
+	buf   bytes.Buffer
+	names map[string]int
+}
+
+func generate(data []byte, pkg, fn, source string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var card map[string]any
+	if err := dec.Decode(&card); err != nil {
+		return nil, fmt.Errorf("parsing card: %w", err)
+	}
+	if card["type"] != "AdaptiveCard" {
+		return nil, fmt.Errorf("top-level type is %v, want AdaptiveCard", card["type"])
+	}
+
+	g := &generator{names: make(map[string]int)}
+	fmt.Fprintf(&g.buf, "// Code generated by cardgen import from %s.\n\n", source)
+	fmt.Fprintf(&g.buf, "package %s\n\n", pkg)
+	g.buf.WriteString("import \"github.com/luisdibdin/adaptivecard\"\n\n")
+	fmt.Fprintf(&g.buf, "func %s() adaptivecard.AdaptiveCard {\n", fn)
+	g.card("card", card)
+	g.buf.WriteString("return card\n}\n")
+
+	return format.Source(g.buf.Bytes())
+}
+
+// card emits the statements building card into the variable name; it is
+// used for the top-level card and for the cards of Action.ShowCard.
+func (g *generator) card(name string, card map[string]any) {
+	g.printf("%s := adaptivecard.AdaptiveCard{Type: \"AdaptiveCard\", Version: %s, Schema: %s}",
+		name, quote(card["version"]), quote(card["$schema"]))
+
+	for _, el := range list(card["body"]) {
+		if n := g.element(el); n != "" {
+			g.printf("%s.AddBody(%s)", name, n)
+		}
+	}
+	for _, a := range list(card["actions"]) {
+		g.printf("%s.AddAction(%s)", name, g.action(a))
+	}
+	g.selectAction(name, card)
+	g.setters(name, card, "fallbackText", "WithFallbackText", "speak", "WithSpeak", "lang", "WithLang")
+	g.unsupported("AdaptiveCard", card, "type", "version", "$schema", "body", "actions", "selectAction",
+		"fallbackText", "speak", "lang")
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format+"\n", args...)
+}
+
+// varName returns a fresh variable name for an element of type typ.
+func (g *generator) varName(typ string) string {
+	base := strings.ToLower(typ[:1]) + strings.NewReplacer(".", "", "Input", "").Replace(typ[1:])
+	if strings.HasPrefix(typ, "Input.") {
+		base = "input" + strings.TrimPrefix(typ, "Input.")
+	}
+	g.names[base]++
+	return fmt.Sprintf("%s%d", base, g.names[base])
+}
+
+// baseKeys are the properties every element supports.
+var baseKeys = []string{"id", "spacing", "separator", "height", "isVisible", "fallback", "requires"}
+
+// element emits the statements building el and returns the variable holding
+// it, or "" when the element type is not supported.
+func (g *generator) element(v any) string {
+	el, _ := v.(map[string]any)
+	typ, _ := el["type"].(string)
+	rest := maps.Clone(el)
+	for _, key := range baseKeys {
+		// Inputs take their ID in the constructor.
+		if key != "id" || !strings.HasPrefix(typ, "Input.") {
+			delete(rest, key)
+		}
+	}
+	name := g.build(typ, rest)
+	if name == "" {
+		return ""
+	}
+	if !strings.HasPrefix(typ, "Input.") {
+		g.setters(name, el, "id", "WithID")
+	}
+	g.setters(name, el, "spacing", "WithSpacing", "height", "WithHeight")
+	g.bools(name, el, "separator", "Separator")
+	if visible, ok := el["isVisible"].(bool); ok {
+		g.printf("%s.WithVisible(%t)", name, visible)
+	}
+	if requires, ok := el["requires"].(map[string]any); ok {
+		for _, capability := range slices.Sorted(maps.Keys(requires)) {
+			g.printf("%s.WithRequires(%s, %s)", name, quote(capability), quote(requires[capability]))
+		}
+	}
+	switch fallback := el["fallback"].(type) {
+	case string:
+		g.printf("%s.WithFallbackDrop()", name)
+	case map[string]any:
+		if fb := g.element(fallback); fb != "" {
+			g.printf("%s.WithFallback(%s)", name, fb)
+		}
+	}
+	return name
+}
+
+// build emits the statements building an element of type typ from its
+// non-base properties.
+func (g *generator) build(typ string, el map[string]any) string {
+	switch typ {
+	case "TextBlock":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewTextBlock(%s)", name, quote(el["text"]))
+		if wrap, ok := el["wrap"].(bool); !ok {
+			g.printf("%s.Wrap = nil", name)
+		} else if !wrap {
+			g.printf("%s.WithWrap(false)", name)
+		}
+		g.bools(name, el, "isSubtle", "IsSubtle")
+		g.setters(name, el, "style", "WithStyle", "weight", "WithWeight", "size", "WithSize", "color", "WithColor",
+			"fontType", "WithFontType", "horizontalAlignment", "WithHorizontalAlignment")
+		if n, ok := el["maxLines"].(json.Number); ok {
+			g.printf("%s.WithMaxLines(%s)", name, n)
+		}
+		g.unsupported(typ, el, "type", "text", "wrap", "style", "weight", "size", "color",
+			"fontType", "horizontalAlignment", "isSubtle", "maxLines")
+		return name
+	case "Container":
+		var items []string
+		for _, item := range list(el["items"]) {
+			if n := g.element(item); n != "" {
+				items = append(items, n)
+			}
+		}
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewContainer(%s)", name, strings.Join(items, ", "))
+		g.setters(name, el, "style", "WithStyle", "minHeight", "WithMinHeight",
+			"verticalContentAlignment", "WithVerticalContentAlignment", "targetWidth", "WithTargetWidth")
+		g.flags(name, el, "bleed", "WithBleed")
+		g.selectAction(name, el)
+		g.unsupported(typ, el, "type", "items", "style", "minHeight", "verticalContentAlignment", "targetWidth", "bleed", "selectAction")
+		return name
+	case "ColumnSet":
+		var columns []string
+		for _, c := range list(el["columns"]) {
+			col, _ := c.(map[string]any)
+			columns = append(columns, g.column(col))
+		}
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewColumnSet(%s)", name, strings.Join(columns, ", "))
+		g.fields(name, el, "targetWidth", "TargetWidth")
+		g.selectAction(name, el)
+		g.unsupported(typ, el, "type", "columns", "targetWidth", "selectAction")
+		return name
+	case "ProgressBar":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewProgressBar(%s, %s)", name, number(el["value"]), number(el["max"]))
+		g.setters(name, el, "color", "WithColor")
+		g.unsupported(typ, el, "type", "value", "max", "color")
+		return name
+	case "FactSet":
+		var facts []string
+		for _, f := range list(el["facts"]) {
+			fact, _ := f.(map[string]any)
+			facts = append(facts, fmt.Sprintf("adaptivecard.Fact{Title: %s, Value: %s}", quote(fact["title"]), quote(fact["value"])))
+		}
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewFactSet(\n%s,\n)", name, strings.Join(facts, ",\n"))
+		g.unsupported(typ, el, "type", "facts")
+		return name
+	case "ActionSet":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewActionSet()", name)
+		for _, a := range list(el["actions"]) {
+			g.printf("%s.AddAction(%s)", name, g.action(a))
+		}
+		g.unsupported(typ, el, "type", "actions")
+		return name
+	case "Table":
+		return g.table(el)
+	case "Image":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewImage(%s)", name, quote(el["url"]))
+		g.setters(name, el, "altText", "WithAltText", "size", "WithSize", "style", "WithStyle", "horizontalAlignment", "WithHorizontalAlignment")
+		g.selectAction(name, el)
+		g.unsupported(typ, el, "type", "url", "altText", "size", "style", "horizontalAlignment", "selectAction")
+		return name
+	case "Media":
+		var sources []string
+		for _, s := range list(el["sources"]) {
+			src, _ := s.(map[string]any)
+			sources = append(sources, fmt.Sprintf("adaptivecard.MediaSource{MimeType: %s, URL: %s}", quote(src["mimeType"]), quote(src["url"])))
+		}
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewMedia(%s)", name, strings.Join(sources, ", "))
+		g.setters(name, el, "poster", "WithPoster", "altText", "WithAltText")
+		for _, c := range list(el["captionSources"]) {
+			cs, _ := c.(map[string]any)
+			g.printf("%s.AddCaptionSource(%s, %s, %s)", name, quote(cs["label"]), quote(cs["mimeType"]), quote(cs["url"]))
+		}
+		g.unsupported(typ, el, "type", "sources", "poster", "altText", "captionSources")
+		return name
+	case "RichTextBlock":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewRichTextBlock()", name)
+		for _, in := range list(el["inlines"]) {
+			run, ok := in.(map[string]any)
+			if !ok {
+				run = map[string]any{"type": "TextRun", "text": in}
+			}
+			r := g.varName("TextRun")
+			g.printf("%s := adaptivecard.NewTextRun(%s)", r, quote(run["text"]))
+			g.setters(r, run, "weight", "WithWeight", "size", "WithSize", "color", "WithColor")
+			g.flags(r, run, "italic", "WithItalic", "strikethrough", "WithStrikethrough", "underline", "WithUnderline",
+				"highlight", "WithHighlight", "isSubtle", "WithSubtle")
+			if a, ok := run["selectAction"]; ok {
+				g.printf("%s.WithSelectAction(%s)", r, g.action(a))
+			}
+			g.unsupported("TextRun", run, "type", "text", "weight", "size", "color", "italic", "strikethrough", "underline",
+				"highlight", "isSubtle", "selectAction")
+			g.printf("%s.AddInline(%s)", name, r)
+		}
+		g.unsupported(typ, el, "type", "inlines")
+		return name
+	case "Icon":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewIcon(%s)", name, quote(el["name"]))
+		g.setters(name, el, "size", "WithSize", "style", "WithStyle", "color", "WithColor")
+		g.selectAction(name, el)
+		g.unsupported(typ, el, "type", "name", "size", "style", "color", "selectAction")
+		return name
+	case "Badge":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewBadge(%s)", name, quote(el["text"]))
+		if icon, ok := el["icon"]; ok {
+			g.printf("%s.WithIcon(%s, %s)", name, quote(icon), quote(el["iconPosition"]))
+		}
+		g.setters(name, el, "appearance", "WithAppearance", "size", "WithSize", "shape", "WithShape", "style", "WithStyle", "tooltip", "WithTooltip")
+		g.unsupported(typ, el, "type", "text", "icon", "iconPosition", "appearance", "size", "shape", "style", "tooltip")
+		return name
+	case "Input.ChoiceSet":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewChoiceSetInput(%s)", name, quote(el["id"]))
+		for _, c := range list(el["choices"]) {
+			choice, _ := c.(map[string]any)
+			g.printf("%s.AddChoice(%s, %s)", name, quote(choice["title"]), quote(choice["value"]))
+		}
+		g.setters(name, el, "label", "WithLabel", "placeholder", "WithPlaceholder", "value", "WithValue")
+		if data, ok := el["choices.data"].(map[string]any); ok {
+			g.printf("%s.WithDataset(%s)", name, quote(data["dataset"]))
+		}
+		if req, _ := el["isRequired"].(bool); req {
+			g.printf("%s.WithRequired(%s)", name, quote(el["errorMessage"]))
+		}
+		if multi, _ := el["isMultiSelect"].(bool); multi {
+			g.printf("%s.IsMultiSelect = true", name)
+		}
+		g.unsupported(typ, el, "type", "id", "choices", "label", "placeholder", "value", "choices.data", "isRequired", "errorMessage", "isMultiSelect")
+		return name
+	case "Input.Date", "Input.Time":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.New%sInput(%s)", name, strings.TrimPrefix(typ, "Input."), quote(el["id"]))
+		g.setters(name, el, "label", "WithLabel", "placeholder", "WithPlaceholder")
+		for _, key := range []string{"value", "min", "max"} {
+			if v, ok := el[key]; ok {
+				g.printf("%s.%s = %s", name, strings.ToUpper(key[:1])+key[1:], quote(v))
+			}
+		}
+		if req, _ := el["isRequired"].(bool); req {
+			g.printf("%s.WithRequired(%s)", name, quote(el["errorMessage"]))
+		}
+		g.unsupported(typ, el, "type", "id", "label", "placeholder", "value", "min", "max", "isRequired", "errorMessage")
+		return name
+	case "Input.Number":
+		name := g.varName(typ)
+		g.printf("%s := adaptivecard.NewNumberInput(%s)", name, quote(el["id"]))
+		g.setters(name, el, "label", "WithLabel", "placeholder", "WithPlaceholder")
+		if v, ok := el["value"].(json.Number); ok {
+			g.printf("%s.WithValue(%s)", name, v)
+		}
+		handled := []string{"type", "id", "label", "placeholder", "value", "isRequired", "errorMessage"}
+		min, minOK := el["min"].(json.Number)
+		max, maxOK := el["max"].(json.Number)
+		if minOK && maxOK {
+			g.printf("%s.WithRange(%s, %s)", name, min, max)
+			handled = append(handled, "min", "max")
+		}
+		if req, _ := el["isRequired"].(bool); req {
+			g.printf("%s.WithRequired(%s)", name, quote(el["errorMessage"]))
+		}
+		g.unsupported(typ, el, handled...)
+		return name
+	}
+
+	g.printf("// TODO(cardgen): element type %q is not supported by the adaptivecard package", typ)
+	return ""
+}
+
+func (g *generator) column(col map[string]any) string {
+	var items []string
+	for _, item := range list(col["items"]) {
+		if n := g.element(item); n != "" {
+			items = append(items, n)
+		}
+	}
+	name := g.varName("Column")
+	g.printf("%s := adaptivecard.NewColumn(%s)", name, strings.Join(items, ", "))
+	switch width := col["width"].(type) {
+	case string:
+		g.printf("%s.WithWidth(%s)", name, quote(width))
+	case json.Number:
+		g.printf("%s.WithWidth(%s)", name, width)
+	}
+	g.selectAction(name, col)
+	g.unsupported("Column", col, "type", "items", "width", "selectAction")
+	return name
+}
+
+func (g *generator) table(el map[string]any) string {
+	name := g.varName("Table")
+	g.printf("%s := adaptivecard.NewTable()", name)
+	for i, c := range list(el["columns"]) {
+		col, _ := c.(map[string]any)
+		width := "1"
+		if w, ok := col["width"].(json.Number); ok {
+			width = w.String()
+		}
+		g.printf("%s.AddColumn(%s)", name, width)
+		if v, ok := col["horizontalCellContentAlignment"]; ok {
+			g.printf("%s.Columns[%d].HorizontalCellContentAlignment = %s", name, i, quote(v))
+		}
+		if v, ok := col["verticalCellContentAlignment"]; ok {
+			g.printf("%s.Columns[%d].VerticalCellContentAlignment = %s", name, i, quote(v))
+		}
+	}
+	for _, r := range list(el["rows"]) {
+		row, _ := r.(map[string]any)
+		var cells []string
+		for _, c := range list(row["cells"]) {
+			cell, _ := c.(map[string]any)
+			var items []string
+			for _, item := range list(cell["items"]) {
+				if n := g.element(item); n != "" {
+					items = append(items, n)
+				}
+			}
+			cellName := g.varName("TableCell")
+			g.printf("%s := adaptivecard.NewTableCell(%s)", cellName, strings.Join(items, ", "))
+			if _, ok := cell["style"]; !ok {
+				// NewTableCell defaults to "accent".
+				g.printf("%s.Style = \"\"", cellName)
+			}
+			g.setters(cellName, cell, "style", "WithStyle", "verticalContentAlignment", "WithVerticalContentAlignment")
+			g.unsupported("TableCell", cell, "type", "items", "style", "verticalContentAlignment")
+			cells = append(cells, cellName)
+		}
+		rowName := g.varName("TableRow")
+		g.printf("%s := adaptivecard.NewTableRow(%s)", rowName, strings.Join(cells, ", "))
+		g.setters(rowName, row, "style", "WithStyle",
+			"horizontalCellContentAlignment", "WithHorizontalCellContentAlignment",
+			"verticalCellContentAlignment", "WithVerticalCellContentAlignment")
+		g.unsupported("TableRow", row, "type", "cells", "style", "horizontalCellContentAlignment", "verticalCellContentAlignment")
+		g.printf("%s.AddTableRow(%s)", name, rowName)
+	}
+	// NewTable sets both flags; clear the ones the card leaves to the host.
+	for _, f := range []struct{ key, field string }{{"firstRowAsHeaders", "FirstRowAsHeaders"}, {"showGridLines", "ShowGridLines"}} {
+		if _, ok := el[f.key]; !ok {
+			g.printf("%s.%s = nil", name, f.field)
+		}
+	}
+	g.bools(name, el, "firstRowAsHeaders", "FirstRowAsHeaders", "showGridLines", "ShowGridLines")
+	g.setters(name, el, "gridStyle", "WithGridStyle")
+	h, _ := el["horizontalCellContentAlignment"].(string)
+	v, _ := el["verticalCellContentAlignment"].(string)
+	if h != "" || v != "" {
+		g.printf("%s.WithCellContentAlignment(%s, %s)", name, quote(h), quote(v))
+	}
+	g.unsupported("Table", el, "type", "columns", "rows", "firstRowAsHeaders", "showGridLines",
+		"gridStyle", "horizontalCellContentAlignment", "verticalCellContentAlignment")
+	return name
+}
+
+// action returns a Go expression for an action object: a typed action, or
+// the flat adaptivecard.Action for types the package has no type for.
+// The card of an Action.ShowCard is built into a variable first.
+func (g *generator) action(v any) string {
+	a, _ := v.(map[string]any)
+	typ, _ := a["type"].(string)
+	fields := []string{"Type: " + quote(a["type"]), "Title: " + quote(a["title"])}
+	handled := []string{"type", "title"}
+	str := func(key, field string) {
+		if s, ok := a[key]; ok {
+			fields = append(fields, field+": "+quote(s))
+		}
+		handled = append(handled, key)
+	}
+	data := func() {
+		if data, ok := a["data"]; ok {
+			fields = append(fields, "Data: "+literal(data))
+		}
+		handled = append(handled, "data")
+	}
+
+	goType := "Action"
+	switch typ {
+	case "Action.OpenUrl":
+		goType = "OpenUrlAction"
+		str("url", "URL")
+	case "Action.Submit":
+		goType = "SubmitAction"
+		data()
+		str("associatedInputs", "AssociatedInputs")
+	case "Action.Execute":
+		goType = "ExecuteAction"
+		str("verb", "Verb")
+		data()
+		str("associatedInputs", "AssociatedInputs")
+	case "Action.ShowCard":
+		goType = "ShowCardAction"
+		if card, ok := a["card"].(map[string]any); ok {
+			name := g.varName("Card")
+			g.card(name, card)
+			fields = append(fields, "Card: &"+name)
+		}
+		handled = append(handled, "card")
+	case "Action.ToggleVisibility":
+		goType = "ToggleVisibilityAction"
+		fields = append(fields, g.targets(a))
+		handled = append(handled, "targetElements")
+	default:
+		str("url", "Url")
+		str("verb", "Verb")
+		data()
+		str("associatedInputs", "AssociatedInputs")
+		if _, ok := a["targetElements"]; ok {
+			fields = append(fields, g.targets(a))
+		}
+		handled = append(handled, "targetElements")
+	}
+	str("mode", "Mode")
+	str("iconUrl", "IconURL")
+	str("style", "Style")
+	str("tooltip", "Tooltip")
+	if enabled, ok := a["isEnabled"].(bool); ok {
+		fields = append(fields, fmt.Sprintf("IsEnabled: adaptivecard.Bool(%t)", enabled))
+	}
+	handled = append(handled, "isEnabled")
+	g.unsupported(typ, a, handled...)
+	return "adaptivecard." + goType + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// targets returns the TargetElements field of an Action.ToggleVisibility.
+func (g *generator) targets(a map[string]any) string {
+	var ts []string
+	for _, t := range list(a["targetElements"]) {
+		switch t := t.(type) {
+		case string:
+			ts = append(ts, fmt.Sprintf("{ElementID: %s}", quote(t)))
+		case map[string]any:
+			target := "ElementID: " + quote(t["elementId"])
+			if visible, ok := t["isVisible"].(bool); ok {
+				target += fmt.Sprintf(", IsVisible: adaptivecard.Bool(%t)", visible)
+			}
+			ts = append(ts, "{"+target+"}")
+		}
+	}
+	return "TargetElements: []adaptivecard.TargetElement{" + strings.Join(ts, ", ") + "}"
+}
+
+// setters emits name.Setter(value) for each (key, setter) pair present in el.
+func (g *generator) setters(name string, el map[string]any, pairs ...string) {
+	for i := 0; i < len(pairs); i += 2 {
+		if v, ok := el[pairs[i]]; ok {
+			g.printf("%s.%s(%s)", name, pairs[i+1], quote(v))
+		}
+	}
+}
+
+// fields emits an assignment of a string for each (key, field) pair present
+// in el, for properties without a setter.
+func (g *generator) fields(name string, el map[string]any, pairs ...string) {
+	for i := 0; i < len(pairs); i += 2 {
+		if v, ok := el[pairs[i]]; ok {
+			g.printf("%s.%s = %s", name, pairs[i+1], quote(v))
+		}
+	}
+}
+
+// selectAction emits name.WithSelectAction for the selectAction of el, if any.
+func (g *generator) selectAction(name string, el map[string]any) {
+	if a, ok := el["selectAction"]; ok {
+		g.printf("%s.WithSelectAction(%s)", name, g.action(a))
+	}
+}
+
+// flags emits name.Setter() for each (key, setter) pair that is true in el.
+func (g *generator) flags(name string, el map[string]any, pairs ...string) {
+	for i := 0; i < len(pairs); i += 2 {
+		if v, _ := el[pairs[i]].(bool); v {
+			g.printf("%s.%s()", name, pairs[i+1])
+		}
+	}
+}
+
+// bools emits an assignment for each optional boolean key present in el,
+// given as pairs of JSON key and field name.
+func (g *generator) bools(name string, el map[string]any, pairs ...string) {
+	for i := 0; i < len(pairs); i += 2 {
+		if v, ok := el[pairs[i]].(bool); ok {
+			g.printf("%s.%s = adaptivecard.Bool(%t)", name, pairs[i+1], v)
+		}
+	}
+}
+
+// unsupported emits a TODO comment listing the keys of el that were not
+// translated.
+func (g *generator) unsupported(typ string, el map[string]any, handled ...string) {
+	var keys []string
+	for k := range el {
+		if !contains(handled, k) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	sort.Strings(keys)
+	g.printf("// TODO(cardgen): %s properties not translated: %s", typ, strings.Join(keys, ", "))
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func list(v any) []any {
+	l, _ := v.([]any)
+	return l
+}
+
+func quote(v any) string {
+	if v == nil {
+		return `""`
+	}
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return strconv.Quote(fmt.Sprint(v))
+}
+
+// number renders a decoded JSON number, or 0 when v is not one.
+func number(v any) string {
+	if n, ok := v.(json.Number); ok {
+		return n.String()
+	}
+	return "0"
+}
+
+// literal renders a decoded JSON value as a Go expression of type any.
+func literal(v any) string {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		parts := make([]string, len(keys))
+		for i, k := range keys {
+			parts[i] = strconv.Quote(k) + ": " + literal(v[k])
+		}
+		return "map[string]any{" + strings.Join(parts, ", ") + "}"
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = literal(item)
+		}
+		return "[]any{" + strings.Join(parts, ", ") + "}"
+	case string:
+		return strconv.Quote(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return "nil"
+}
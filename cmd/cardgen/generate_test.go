@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard/cmd/cardgen/internal/golden"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden generated code")
+
+const (
+	goldenInput  = "testdata/card.json"
+	goldenOutput = "internal/golden/card.go"
+)
+
+// TestGenerateGolden checks the code generated for testdata/card.json. The
+// generated code is compiled into the golden package, so
+// TestGeneratedCardMarshalsToInput can check what it builds.
+func TestGenerateGolden(t *testing.T) {
+	input, err := os.ReadFile(goldenInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(input, "golden", "NewCard", goldenInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := os.WriteFile(goldenOutput, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(goldenOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated code differs from %s; run go test -update\n%s", goldenOutput, got)
+	}
+	if bytes.Contains(got, []byte("TODO(cardgen)")) {
+		t.Errorf("generated code has untranslated properties:\n%s", got)
+	}
+}
+
+func TestGeneratedCardMarshalsToInput(t *testing.T) {
+	input, err := os.ReadFile(filepath.FromSlash(goldenInput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := json.Marshal(golden.NewCard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want, got any
+	if err := json.Unmarshal(input, &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(output, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("generated card marshals to\n%s\nwant\n%s", output, input)
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by cardgen import from testdata/card.json.
+
+package golden
+
+import "github.com/luisdibdin/adaptivecard"
+
+func NewCard() adaptivecard.AdaptiveCard {
+	card := adaptivecard.AdaptiveCard{Type: "AdaptiveCard", Version: "1.5", Schema: "http://adaptivecards.io/schemas/adaptive-card.json"}
+	textBlock1 := adaptivecard.NewTextBlock("Deploy finished")
+	textBlock1.WithStyle("heading")
+	textBlock1.WithWeight("bolder")
+	textBlock1.WithSize("large")
+	card.AddBody(textBlock1)
+	icon1 := adaptivecard.NewIcon("CheckmarkCircle")
+	icon1.WithColor("good")
+	column1 := adaptivecard.NewColumn(icon1)
+	column1.WithWidth("auto")
+	textBlock2 := adaptivecard.NewTextBlock("42 of 42 checks passed")
+	textBlock2.Wrap = nil
+	textBlock2.IsSubtle = adaptivecard.Bool(true)
+	column2 := adaptivecard.NewColumn(textBlock2)
+	column2.WithWidth(2)
+	columnSet1 := adaptivecard.NewColumnSet(column1, column2)
+	columnSet1.WithSelectAction(adaptivecard.OpenUrlAction{Type: "Action.OpenUrl", Title: "Open", URL: "https://example.com/run/42"})
+	columnSet1.WithID("summary")
+	columnSet1.WithSpacing("medium")
+	card.AddBody(columnSet1)
+	progressBar1 := adaptivecard.NewProgressBar(42, 42)
+	progressBar1.WithColor("good")
+	card.AddBody(progressBar1)
+	factSet1 := adaptivecard.NewFactSet(
+		adaptivecard.Fact{Title: "Service", Value: "api"},
+		adaptivecard.Fact{Title: "Region", Value: "westeurope"},
+	)
+	card.AddBody(factSet1)
+	table1 := adaptivecard.NewTable()
+	table1.AddColumn(1)
+	table1.AddColumn(2)
+	textBlock3 := adaptivecard.NewTextBlock("Stage")
+	textBlock3.Wrap = nil
+	tableCell1 := adaptivecard.NewTableCell(textBlock3)
+	tableCell1.Style = ""
+	textBlock4 := adaptivecard.NewTextBlock("Result")
+	textBlock4.Wrap = nil
+	tableCell2 := adaptivecard.NewTableCell(textBlock4)
+	tableCell2.WithStyle("good")
+	tableRow1 := adaptivecard.NewTableRow(tableCell1, tableCell2)
+	table1.AddTableRow(tableRow1)
+	table1.FirstRowAsHeaders = nil
+	table1.ShowGridLines = nil
+	card.AddBody(table1)
+	table2 := adaptivecard.NewTable()
+	table2.AddColumn(1)
+	table2.FirstRowAsHeaders = adaptivecard.Bool(false)
+	table2.ShowGridLines = adaptivecard.Bool(true)
+	card.AddBody(table2)
+	actionSet1 := adaptivecard.NewActionSet()
+	actionSet1.AddAction(adaptivecard.ToggleVisibilityAction{Type: "Action.ToggleVisibility", Title: "Details", TargetElements: []adaptivecard.TargetElement{{ElementID: "summary", IsVisible: adaptivecard.Bool(false)}}})
+	actionSet1.AddAction(adaptivecard.ExecuteAction{Type: "Action.Execute", Title: "Retry", Verb: "retry", Data: map[string]any{"run": 42}, Style: "positive", Tooltip: "Run the pipeline again", IsEnabled: adaptivecard.Bool(true)})
+	card.AddBody(actionSet1)
+	card.AddAction(adaptivecard.OpenUrlAction{Type: "Action.OpenUrl", Title: "View run", URL: "https://example.com/run/42", IconURL: "https://example.com/icon.png"})
+	card1 := adaptivecard.AdaptiveCard{Type: "AdaptiveCard", Version: "", Schema: ""}
+	inputChoiceSet1 := adaptivecard.NewChoiceSetInput("target")
+	inputChoiceSet1.AddChoice("41", "41")
+	inputChoiceSet1.WithLabel("Version")
+	card1.AddBody(inputChoiceSet1)
+	card1.AddAction(adaptivecard.SubmitAction{Type: "Action.Submit", Title: "Roll back", Data: map[string]any{"action": "rollback"}, Style: "destructive"})
+	card.AddAction(adaptivecard.ShowCardAction{Type: "Action.ShowCard", Title: "Roll back", Card: &card1})
+	return card
+}
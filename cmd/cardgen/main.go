@@ -0,0 +1,90 @@
+// Command cardgen is a companion tool for the adaptivecard package.
+//
+// Usage:
+//
+//	cardgen import [-pkg name] [-func name] [-o file.go] card.json
+//	cardgen features
+//
+// import reads a card exported from the Adaptive Cards Designer (or "-" for
+// stdin) and writes Go code that rebuilds it with the adaptivecard
+// constructors and setters.
+//
+// features prints, as JSON, the schema versions, elements, actions and
+// properties supported by the adaptivecard package.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "features":
+		err = runFeatures()
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cardgen:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: cardgen import [-pkg name] [-func name] [-o file.go] card.json")
+	fmt.Fprintln(os.Stderr, "       cardgen features")
+	os.Exit(2)
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	pkg := fs.String("pkg", "cards", "package name of the generated file")
+	fn := fs.String("func", "NewCard", "name of the generated constructor")
+	out := fs.String("o", "", "output file (default stdout)")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		usage()
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if name := fs.Arg(0); name == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(name)
+	}
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(data, *pkg, *fn, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+func runFeatures() error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(adaptivecard.SupportedFeatures())
+}
@@ -0,0 +1,103 @@
+package adaptivecard
+
+import "encoding/json"
+
+// ----------------------
+// ColumnSet
+// ----------------------
+type ColumnSet struct {
+	Type string `json:"type"`
+	BaseElement
+	TargetWidth  string   `json:"targetWidth,omitempty"`
+	SelectAction *Action  `json:"selectAction,omitempty"`
+	Columns      []Column `json:"columns"`
+}
+
+// Column is a vertical slice of a ColumnSet. Width is "auto", "stretch", a
+// relative weight (number) or a pixel width such as "50px".
+type Column struct {
+	Type         string    `json:"type"`
+	Width        any       `json:"width,omitempty"`
+	SelectAction *Action   `json:"selectAction,omitempty"`
+	Items        []Element `json:"items"`
+	// Extra holds column properties the package has no field for, e.g.
+	// backgroundImage or style; see BaseElement.Extra.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func NewColumnSet(columns ...Column) ColumnSet {
+	return ColumnSet{
+		Type:    "ColumnSet",
+		Columns: columns,
+	}
+}
+
+func NewColumn(items ...Element) Column {
+	return Column{
+		Type:  "Column",
+		Items: items,
+	}
+}
+func (ColumnSet) isElement() {}
+func (cs ColumnSet) toRaw() any {
+	columns := make([]any, len(cs.Columns))
+	for i, col := range cs.Columns {
+		columns[i] = col.toRaw()
+	}
+	return struct {
+		Type string `json:"type"`
+		BaseElement
+		TargetWidth  string  `json:"targetWidth,omitempty"`
+		SelectAction *Action `json:"selectAction,omitempty"`
+		Columns      []any   `json:"columns"`
+	}{
+		Type:         cs.Type,
+		BaseElement:  cs.BaseElement,
+		TargetWidth:  cs.TargetWidth,
+		SelectAction: cs.SelectAction,
+		Columns:      columns,
+	}
+}
+
+func (col Column) toRaw() any {
+	items := make([]any, len(col.Items))
+	for i, el := range col.Items {
+		items[i] = rawOf(el)
+	}
+	return extraOf(struct {
+		Type         string  `json:"type"`
+		Width        any     `json:"width,omitempty"`
+		SelectAction *Action `json:"selectAction,omitempty"`
+		Items        []any   `json:"items"`
+	}{
+		Type:         col.Type,
+		Width:        col.Width,
+		SelectAction: col.SelectAction,
+		Items:        items,
+	}, col.Extra)
+}
+
+func (cs *ColumnSet) AddColumn(col Column) {
+	cs.Columns = append(cs.Columns, col)
+}
+
+// WithSelectAction makes the whole column set tappable.
+func (cs *ColumnSet) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	cs.SelectAction = &a
+}
+
+// WithSelectAction makes the column tappable.
+func (col *Column) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	col.SelectAction = &a
+}
+
+// WithWidth sets "auto", "stretch", a relative weight or a pixel width.
+func (col *Column) WithWidth(width any) {
+	col.Width = width
+}
+
+func (col *Column) AddItem(el Element) {
+	col.Items = append(col.Items, el)
+}
@@ -0,0 +1,114 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// schemaDefaults are property values the schema assumes when a property is
+// absent, so emitting them only costs payload size.
+var schemaDefaults = map[string]any{
+	"wrap":                     false,
+	"separator":                false,
+	"isSubtle":                 false,
+	"italic":                   false,
+	"strikethrough":            false,
+	"underline":                false,
+	"highlight":                false,
+	"isRequired":               false,
+	"isMultiSelect":            false,
+	"bleed":                    false,
+	"isVisible":                true,
+	"firstRowAsHeaders":        true,
+	"showGridLines":            true,
+	"spacing":                  "default",
+	"weight":                   "default",
+	"size":                     "default",
+	"color":                    "default",
+	"fontType":                 "default",
+	"height":                   "auto",
+	"style":                    "default",
+	"verticalContentAlignment": "top",
+	"mode":                     "primary",
+}
+
+// requiredKeys are kept even when empty.
+var requiredKeys = map[string]bool{
+	"type": true, "version": true, "text": true, "title": true, "value": true,
+	"url": true, "elementId": true, "body": true, "items": true, "facts": true,
+	"columns": true, "rows": true, "cells": true, "inlines": true,
+	"sources": true, "choices": true,
+}
+
+// Compact returns the card's JSON with every property that equals its schema
+// default (wrap:false, spacing:"default", empty strings, empty optional
+// arrays, ...) removed, and with containers that only wrap a single element
+// replaced by that element. Use it when a card is close to the host's payload
+// size limit; the rendered result is unchanged.
+func (c AdaptiveCard) Compact() ([]byte, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := orderedjson.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return orderedjson.Marshal(compactValue(tree))
+}
+
+func compactValue(v any) any {
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		kept := v.Members[:0]
+		for _, m := range v.Members {
+			m.Value = compactValue(m.Value)
+			if !requiredKeys[m.Key] && isDefault(m.Key, m.Value) {
+				continue
+			}
+			kept = append(kept, m)
+		}
+		v.Members = kept
+		return collapseContainer(v)
+	case []any:
+		for i, item := range v {
+			v[i] = compactValue(item)
+		}
+		return v
+	}
+	return v
+}
+
+func isDefault(key string, v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		if v == "" {
+			return true
+		}
+	case []any:
+		return len(v) == 0
+	case *orderedjson.Object:
+		return len(v.Members) == 0
+	}
+	def, ok := schemaDefaults[key]
+	return ok && def == v
+}
+
+// collapseContainer replaces a Container that has no properties besides a
+// single item with that item.
+func collapseContainer(o *orderedjson.Object) any {
+	if len(o.Members) != 2 {
+		return o
+	}
+	if typ, _ := o.Get("type"); typ != "Container" {
+		return o
+	}
+	items, _ := o.Get("items")
+	if list, ok := items.([]any); ok && len(list) == 1 {
+		return list[0]
+	}
+	return o
+}
@@ -0,0 +1,126 @@
+package adaptivecard
+
+// Conditional is an element that is only emitted when Pred reports true at
+// marshal time; otherwise it is dropped from its parent. It lets optional
+// sections sit inline in composite literals:
+//
+//	card.AddBody(adaptivecard.NewContainer(
+//		summary,
+//		adaptivecard.When(len(failures) > 0, failureList),
+//	))
+type Conditional struct {
+	Pred    func() bool
+	Element Element
+}
+
+// When includes el only if cond is true.
+func When(cond bool, el Element) Conditional {
+	return Conditional{Pred: func() bool { return cond }, Element: el}
+}
+
+// WhenFunc includes el only if pred reports true when the card is marshaled,
+// e.g. for cards built once and sent repeatedly.
+func WhenFunc(pred func() bool, el Element) Conditional {
+	return Conditional{Pred: pred, Element: el}
+}
+
+func (Conditional) isElement() {}
+
+// toRaw is only reached for a Conditional outside a card body; the card
+// resolves conditionals before marshaling.
+func (c Conditional) toRaw() any {
+	if !c.included() {
+		return nil
+	}
+	return rawOf(c.Element)
+}
+
+func (c Conditional) included() bool {
+	return c.Element != nil && (c.Pred == nil || c.Pred())
+}
+
+// AddBodyIf appends el to the body only if cond is true.
+func (c *AdaptiveCard) AddBodyIf(cond bool, el Element) {
+	if cond {
+		c.AddBody(el)
+	}
+}
+
+// AddItemIf appends el to the container only if cond is true.
+func (c *Container) AddItemIf(cond bool, el Element) {
+	if cond {
+		c.AddItem(el)
+	}
+}
+
+// unwrapConditional returns the element el stands for: el itself, or for a
+// Conditional its element, or nil when the predicate is false.
+func unwrapConditional(el Element) Element {
+	for {
+		c, ok := el.(Conditional)
+		if !ok {
+			return el
+		}
+		if !c.included() {
+			return nil
+		}
+		el = c.Element
+	}
+}
+
+// hasConditionals reports whether the tree contains a Conditional.
+func hasConditionals(elements []Element) bool {
+	for _, el := range elements {
+		if _, ok := el.(Conditional); ok {
+			return true
+		}
+		if p, ok := el.(parent); ok {
+			for _, c := range p.children() {
+				if hasConditionals([]Element{c.el}) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// resolveConditionals replaces every Conditional in the tree with its element
+// or, when its predicate is false, removes it.
+func resolveConditionals(elements []Element) []Element {
+	if !hasConditionals(elements) {
+		return elements
+	}
+	var resolve func(Element) Element
+	resolve = func(el Element) Element {
+		el = unwrapConditional(el)
+		p, ok := el.(parent)
+		if !ok {
+			return el
+		}
+		switch el := p.mapChildren(resolve).(type) {
+		case Container:
+			el.Items = dropNil(el.Items)
+			return el
+		case ColumnSet:
+			for i := range el.Columns {
+				el.Columns[i].Items = dropNil(el.Columns[i].Items)
+			}
+			return el
+		case Table:
+			for i := range el.Rows {
+				for j := range el.Rows[i].Cells {
+					el.Rows[i].Cells[j].Items = dropNil(el.Rows[i].Cells[j].Items)
+				}
+			}
+			return el
+		default:
+			return el
+		}
+	}
+	out := make([]Element, 0, len(elements))
+	for _, el := range elements {
+		out = append(out, resolve(el))
+	}
+	return dropNil(out)
+}
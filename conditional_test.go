@@ -0,0 +1,112 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConditionalMarshal(t *testing.T) {
+	card := newTestCard(
+		When(true, NewTextBlock("kept")),
+		When(false, NewTextBlock("dropped")),
+		NewContainer(When(false, NewTextBlock("nested")), When(true, When(true, NewTextBlock("double")))),
+	)
+	got := mustMarshal(t, card)
+	for _, want := range []string{`"kept"`, `"double"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("marshaled card lacks %s: %s", want, got)
+		}
+	}
+	for _, unwanted := range []string{"dropped", "nested", "Conditional"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("marshaled card contains %q: %s", unwanted, got)
+		}
+	}
+}
+
+func TestConditionalWalkers(t *testing.T) {
+	tests := []struct {
+		name string
+		card AdaptiveCard
+		// check returns a description of the failure, or "".
+		check func(AdaptiveCard) string
+	}{
+		{
+			name: "ValidateURLs",
+			card: newTestCard(When(true, NewImage("javascript:alert(1)"))),
+			check: func(c AdaptiveCard) string {
+				if c.ValidateURLs(DenyHosts("evil.example")) == nil {
+					return "URL inside a conditional was not checked"
+				}
+				return ""
+			},
+		},
+		{
+			name: "MapText",
+			card: newTestCard(NewContainer(When(true, NewTextBlock("token=SECRET")))),
+			check: func(c AdaptiveCard) string {
+				c.Use(MapText(func(s string) string { return strings.ReplaceAll(s, "SECRET", "***") }))
+				data, err := c.MarshalJSON()
+				if err != nil {
+					return err.Error()
+				}
+				if strings.Contains(string(data), "SECRET") {
+					return "text inside a conditional was not redacted: " + string(data)
+				}
+				return ""
+			},
+		},
+		{
+			name: "AuditAccessibility",
+			card: newTestCard(When(true, NewImage("https://example.com/a.png"))),
+			check: func(c AdaptiveCard) string {
+				for _, f := range c.AuditAccessibility() {
+					if f.Rule == RuleAltText {
+						return ""
+					}
+				}
+				return "image without alt text inside a conditional was not reported"
+			},
+		},
+		{
+			name: "Stats",
+			card: newTestCard(When(true, NewTextBlock("x")), When(false, NewImage("https://example.com/a.png"))),
+			check: func(c AdaptiveCard) string {
+				s := c.Stats()
+				if s.Elements["Conditional"] != 0 || s.Elements["TextBlock"] != 1 || s.Elements["Image"] != 0 {
+					return "unexpected element counts"
+				}
+				return ""
+			},
+		},
+		{
+			name: "DumpTree",
+			card: newTestCard(When(true, NewTextBlock("x"))),
+			check: func(c AdaptiveCard) string {
+				if tree := c.DumpTree(); strings.Contains(tree, "Conditional") || !strings.Contains(tree, "TextBlock") {
+					return "tree shows the wrapper instead of its element:\n" + tree
+				}
+				return ""
+			},
+		},
+		{
+			name: "ValidateShowCards",
+			card: newTestCard(When(true, NewActionSet(
+				NewShowCardAction("a", newTestCard(NewActionSet(NewShowCardAction("b", newTestCard())))),
+			))),
+			check: func(c AdaptiveCard) string {
+				if c.ValidateShowCards(1) == nil {
+					return "nested ShowCard inside a conditional was not reported"
+				}
+				return ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if msg := tt.check(tt.card); msg != "" {
+				t.Error(msg)
+			}
+		})
+	}
+}
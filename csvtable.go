@@ -0,0 +1,191 @@
+package adaptivecard
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DefaultTableMaxRows is the number of data rows the table builders keep
+// unless TableMaxRows says otherwise; larger tables get slow to render in
+// Teams.
+const DefaultTableMaxRows = 50
+
+// TableOption configures NewTableFromCSV, NewTableFromStrings and
+// NewTableFromStructs.
+type TableOption func(*tableOptions)
+
+type tableOptions struct {
+	header  *bool
+	maxRows int
+	comma   rune
+}
+
+// TableHeader forces the first row to be treated as a header row (or not),
+// instead of detecting it.
+func TableHeader(header bool) TableOption {
+	return func(o *tableOptions) { o.header = &header }
+}
+
+// TableMaxRows limits the number of data rows; the rest are replaced by a
+// single "… and N more rows" row. Zero or less means no limit.
+func TableMaxRows(n int) TableOption {
+	return func(o *tableOptions) { o.maxRows = n }
+}
+
+// CSVComma sets the field delimiter, e.g. '\t' or ';'.
+func CSVComma(r rune) TableOption {
+	return func(o *tableOptions) { o.comma = r }
+}
+
+// NewTableFromCSV reads CSV records into a Table with equal-width columns
+// and TextBlock cells. The first record becomes the header when it contains
+// no numbers (override with TableHeader), and columns whose values are all
+// numeric are right-aligned.
+func NewTableFromCSV(r io.Reader, opts ...TableOption) (Table, error) {
+	o := newTableOptions(opts)
+
+	cr := csv.NewReader(r)
+	cr.Comma = o.comma
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return Table{}, fmt.Errorf("reading csv: %w", err)
+	}
+
+	return o.table(records, detectCSVHeader(records)), nil
+}
+
+// NewTableFromStrings is NewTableFromCSV for rows already in memory. Rows
+// may have different lengths; short ones are padded with empty cells.
+func NewTableFromStrings(rows [][]string, opts ...TableOption) Table {
+	o := newTableOptions(opts)
+	return o.table(rows, detectCSVHeader(rows))
+}
+
+func newTableOptions(opts []TableOption) tableOptions {
+	o := tableOptions{maxRows: DefaultTableMaxRows, comma: ','}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// table lays out records with equal-width columns, right-aligning numeric
+// ones. header is the default for whether the first record is a header row.
+func (o tableOptions) table(records [][]string, header bool) Table {
+	table := NewTable()
+	table.WithFirstRowAsHeaders(false)
+	if len(records) == 0 {
+		return table
+	}
+
+	if o.header != nil {
+		header = *o.header
+	}
+	data := records
+	if header {
+		data = records[1:]
+	}
+
+	cols := 0
+	for _, rec := range records {
+		cols = max(cols, len(rec))
+	}
+	for j := 0; j < cols; j++ {
+		table.AddColumn(1)
+		if numericColumn(data, j) {
+			table.Columns[j].HorizontalCellContentAlignment = "right"
+		}
+	}
+
+	if header {
+		table.WithFirstRowAsHeaders(true)
+		cells := make([]TableCell, cols)
+		for j := range cells {
+			tb := NewTextBlock(field(records[0], j))
+			tb.WithWeight(WeightBolder)
+			cells[j] = NewTableCell(tb)
+		}
+		table.AddRow(cells...)
+	}
+
+	truncated := 0
+	if o.maxRows > 0 && len(data) > o.maxRows {
+		truncated = len(data) - o.maxRows
+		data = data[:o.maxRows]
+	}
+	for _, rec := range data {
+		cells := make([]TableCell, cols)
+		for j := range cells {
+			cells[j] = NewTableCell(NewTextBlock(field(rec, j)))
+		}
+		table.AddRow(cells...)
+	}
+
+	// With no columns there is nowhere to put the note; the rows it would
+	// summarize are empty anyway.
+	if truncated > 0 && cols > 0 {
+		noun := "rows"
+		if truncated == 1 {
+			noun = "row"
+		}
+		note := NewTextBlock(fmt.Sprintf("… and %d more %s", truncated, noun))
+		note.WithSubtle()
+		cells := make([]TableCell, cols)
+		cells[0] = NewTableCell(note)
+		for j := 1; j < cols; j++ {
+			cells[j] = NewTableCell([]Element{}...)
+		}
+		table.AddRow(cells...)
+	}
+
+	return table
+}
+
+func field(rec []string, j int) string {
+	if j < len(rec) {
+		return strings.TrimSpace(rec[j])
+	}
+	return ""
+}
+
+func isNumeric(s string) bool {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "%")
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+// detectCSVHeader reports whether the first record looks like column names:
+// every field is non-empty and none is a number.
+func detectCSVHeader(records [][]string) bool {
+	if len(records) < 2 {
+		return false
+	}
+	for _, f := range records[0] {
+		f = strings.TrimSpace(f)
+		if f == "" || isNumeric(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// numericColumn reports whether every non-empty value in column j is a
+// number, with at least one value present.
+func numericColumn(records [][]string, j int) bool {
+	seen := false
+	for _, rec := range records {
+		f := field(rec, j)
+		if f == "" {
+			continue
+		}
+		if !isNumeric(f) {
+			return false
+		}
+		seen = true
+	}
+	return seen
+}
@@ -0,0 +1,68 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewTableFromStrings(t *testing.T) {
+	many := make([][]string, DefaultTableMaxRows+10)
+	for i := range many {
+		many[i] = []string{"x", "1"}
+	}
+
+	tests := []struct {
+		name       string
+		rows       [][]string
+		opts       []TableOption
+		wantCols   int
+		wantRows   int
+		wantHeader bool
+	}{
+		{"empty", nil, nil, 0, 0, false},
+		{"detected header", [][]string{{"Name", "Count"}, {"a", "1"}}, nil, 2, 2, true},
+		{"numeric first row", [][]string{{"a", "1"}, {"b", "2"}}, nil, 2, 2, false},
+		{"forced header", [][]string{{"a", "1"}, {"b", "2"}}, []TableOption{TableHeader(true)}, 2, 2, true},
+		{"ragged rows", [][]string{{"1"}, {"b", "2", "3"}}, nil, 3, 2, false},
+		{"truncated", many, nil, 2, DefaultTableMaxRows + 1, false},
+		{"custom limit", many, []TableOption{TableMaxRows(5)}, 2, 6, false},
+		{"no limit", many, []TableOption{TableMaxRows(0)}, 2, len(many), false},
+		{"empty rows over the limit", make([][]string, DefaultTableMaxRows+10), nil, 0, 1 + DefaultTableMaxRows, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := NewTableFromStrings(tt.rows, tt.opts...)
+			if len(table.Columns) != tt.wantCols || len(table.Rows) != tt.wantRows {
+				t.Fatalf("got %d columns and %d rows, want %d and %d", len(table.Columns), len(table.Rows), tt.wantCols, tt.wantRows)
+			}
+			if got := *table.FirstRowAsHeaders; got != tt.wantHeader {
+				t.Errorf("FirstRowAsHeaders = %v, want %v", got, tt.wantHeader)
+			}
+			for i, row := range table.Rows {
+				if len(row.Cells) != tt.wantCols {
+					t.Errorf("row %d has %d cells, want %d", i, len(row.Cells), tt.wantCols)
+				}
+			}
+		})
+	}
+}
+
+func TestNewTableFromCSV(t *testing.T) {
+	table, err := NewTableFromCSV(strings.NewReader("Name;Count\na;1\nb;22\n"), CSVComma(';'))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Columns) != 2 || len(table.Rows) != 3 {
+		t.Fatalf("got %d columns and %d rows, want 2 and 3", len(table.Columns), len(table.Rows))
+	}
+	if got := table.Columns[1].HorizontalCellContentAlignment; got != "right" {
+		t.Errorf("numeric column alignment = %q, want right", got)
+	}
+	if got := table.Columns[0].HorizontalCellContentAlignment; got != "" {
+		t.Errorf("text column alignment = %q, want none", got)
+	}
+
+	if _, err := NewTableFromCSV(strings.NewReader("a,\"b\n")); err == nil {
+		t.Error("malformed CSV accepted")
+	}
+}
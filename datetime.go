@@ -0,0 +1,104 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateStyle selects how the host renders a {{DATE()}} function.
+type DateStyle string
+
+const (
+	// DateCompact renders e.g. "2/14/2017" (the host default).
+	DateCompact DateStyle = "COMPACT"
+	// DateShort renders e.g. "Tue, Feb 14, 2017".
+	DateShort DateStyle = "SHORT"
+	// DateLong renders e.g. "Tuesday, February 14, 2017".
+	DateLong DateStyle = "LONG"
+)
+
+// DateFunc returns the {{DATE(...)}} text function for t, which the host
+// renders in the viewer's locale and time zone. Use it inside TextBlock text.
+func DateFunc(t time.Time, style DateStyle) string {
+	if style == "" {
+		style = DateCompact
+	}
+	return fmt.Sprintf("{{DATE(%s, %s)}}", formatRFC3339(t), style)
+}
+
+// TimeFunc returns the {{TIME(...)}} text function for t, rendered as a
+// localized time such as "6:08 AM".
+func TimeFunc(t time.Time) string {
+	return fmt.Sprintf("{{TIME(%s)}}", formatRFC3339(t))
+}
+
+// DateTimeFunc combines DateFunc and TimeFunc, e.g. "Tue, Feb 14, 2017 6:08 AM".
+func DateTimeFunc(t time.Time, style DateStyle) string {
+	return DateFunc(t, style) + " " + TimeFunc(t)
+}
+
+// formatRFC3339 formats t the way the DATE/TIME functions require: RFC 3339
+// without fractional seconds, in UTC with a "Z" suffix.
+func formatRFC3339(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// RelativeTime returns a Container showing how long ago t was ("3 minutes
+// ago") with the absolute, viewer-localized date and time underneath in a
+// subtle TextBlock. The relative text is computed when the card is built.
+func RelativeTime(t time.Time) Container {
+	relative := NewTextBlock(RelativeTimeText(t, time.Now()))
+
+	absolute := NewTextBlock(DateTimeFunc(t, DateShort))
+	absolute.WithSize(SizeSmall)
+	absolute.WithSubtle()
+
+	return NewContainer(relative, absolute)
+}
+
+// RelativeTimeText describes t relative to now, e.g. "just now",
+// "5 minutes ago", "yesterday" or "in 2 hours".
+func RelativeTimeText(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var amount string
+	switch {
+	case d < 45*time.Second:
+		return "just now"
+	case d < 90*time.Second:
+		amount = "a minute"
+	case d < 45*time.Minute:
+		amount = plural(int((d+30*time.Second)/time.Minute), "minute")
+	case d < 90*time.Minute:
+		amount = "an hour"
+	case d < 22*time.Hour:
+		amount = plural(int((d+30*time.Minute)/time.Hour), "hour")
+	case d < 36*time.Hour:
+		if future {
+			return "tomorrow"
+		}
+		return "yesterday"
+	case d < 26*24*time.Hour:
+		amount = plural(int((d+12*time.Hour)/(24*time.Hour)), "day")
+	case d < 320*24*time.Hour:
+		amount = plural(max(1, int(d/(30*24*time.Hour))), "month")
+	default:
+		amount = plural(max(1, int(d/(365*24*time.Hour))), "year")
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,30 @@
+package adaptivecard
+
+import "net/url"
+
+// MessageDeepLink returns the Teams link that opens a channel message.
+// teamID is the team's group (AAD) ID, channelID the "19:...@thread.tacv2"
+// ID and messageID the ID of the message, which for channel posts is also its
+// creation time in milliseconds. Replies link to their thread by passing the
+// root message ID as parentMessageID; leave it empty for root posts.
+func MessageDeepLink(teamID, channelID, messageID, parentMessageID string) string {
+	if parentMessageID == "" {
+		parentMessageID = messageID
+	}
+	q := url.Values{}
+	q.Set("groupId", teamID)
+	q.Set("parentMessageId", parentMessageID)
+	q.Set("createdTime", messageID)
+	return "https://teams.microsoft.com/l/message/" + url.PathEscape(channelID) + "/" +
+		url.PathEscape(messageID) + "?" + q.Encode()
+}
+
+// NewOpenMessageAction returns an Action.OpenUrl button that opens a channel
+// message, e.g. "View original alert" on a follow-up card.
+func NewOpenMessageAction(title, teamID, channelID, messageID string) OpenUrlAction {
+	return OpenUrlAction{
+		Type:  "Action.OpenUrl",
+		Title: title,
+		URL:   MessageDeepLink(teamID, channelID, messageID, ""),
+	}
+}
@@ -0,0 +1,343 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Change records one rewrite made by DowngradeTo.
+type Change struct {
+	// Path is the JSON path of the affected element or action in the
+	// original card.
+	Path string
+	// From is the original type, or "Type.property" for removed properties.
+	From string
+	// To is the replacement type, empty when the element was dropped.
+	To string
+}
+
+func (c Change) String() string {
+	if c.To == "" {
+		return fmt.Sprintf("%s: dropped %s", c.Path, c.From)
+	}
+	return fmt.Sprintf("%s: %s -> %s", c.Path, c.From, c.To)
+}
+
+// iconEmoji stands in for Fluent icons on hosts without the Icon element.
+var iconEmoji = map[IconName]string{
+	IconAlert:           "🔔",
+	IconCalendar:        "📅",
+	IconCheckmark:       "✔️",
+	IconCheckmarkCircle: "✅",
+	IconClock:           "🕒",
+	IconDismiss:         "✖️",
+	IconDismissCircle:   "❌",
+	IconErrorCircle:     "⛔",
+	IconInfo:            "ℹ️",
+	IconLink:            "🔗",
+	IconLockClosed:      "🔒",
+	IconMail:            "✉️",
+	IconPerson:          "👤",
+	IconPeople:          "👥",
+	IconRocket:          "🚀",
+	IconSearch:          "🔍",
+	IconSettings:        "⚙️",
+	IconStar:            "⭐",
+	IconWarning:         "⚠️",
+}
+
+// badgeColors maps Badge styles to the closest TextBlock color.
+var badgeColors = map[string]Color{
+	"Default":     ColorDefault,
+	"Subtle":      ColorDefault,
+	"Informative": ColorAccent,
+	"Accent":      ColorAccent,
+	"Good":        ColorGood,
+	"Warning":     ColorWarning,
+	"Attention":   ColorAttention,
+}
+
+// DowngradeTo rewrites the card so it only uses elements, actions and
+// properties available in schema version, then sets the card version to it.
+// Elements are replaced by their fallback when they have one, otherwise by
+// the closest supported equivalent — Table becomes a grid of ColumnSets,
+// RichTextBlock a markdown TextBlock, Icon an emoji, Badge and ProgressBar a
+// TextBlock, Media a link — and anything that cannot be expressed is
+// dropped. Properties newer than version, as listed in the version tables
+// ValidateForVersion uses, are removed. Every rewrite is reported in the
+// returned changes.
+func (c *AdaptiveCard) DowngradeTo(version string) []Change {
+	var changes []Change
+	record := func(path, from, to string) {
+		changes = append(changes, Change{Path: path, From: from, To: to})
+	}
+
+	c.Body = transformPaths(c.Body, "$.body", func(path string, el Element) Element {
+		return downgradeElement(path, el, version, record)
+	})
+	c.Body = dropNil(c.Body)
+
+	actions := c.Actions[:0:0]
+	for i, a := range c.Actions {
+		if a, ok := downgradeAction(fmt.Sprintf("$.actions[%d]", i), a, version, record); ok {
+			actions = append(actions, a)
+		}
+	}
+	if c.Actions != nil {
+		c.Actions = actions
+	}
+
+	stripProperties("$", "AdaptiveCard", reflect.ValueOf(c).Elem(), version, record)
+
+	c.Version = version
+	return changes
+}
+
+// downgradeElement returns the replacement for el, or nil to drop it.
+// Children have already been downgraded.
+func downgradeElement(path string, el Element, version string, record func(path, from, to string)) Element {
+	typ := elementType(el)
+	if !supportsType(version, typ) {
+		return replaceElement(path, el, version, record)
+	}
+
+	v := reflect.New(reflect.TypeOf(el)).Elem()
+	v.Set(reflect.ValueOf(el))
+	stripProperties(path, typ, v, version, record)
+	el = v.Interface().(Element)
+
+	switch el := el.(type) {
+	case Container:
+		el.Items = dropNil(el.Items)
+		return el
+	case ColumnSet:
+		for i := range el.Columns {
+			el.Columns[i].Items = dropNil(el.Columns[i].Items)
+		}
+		return el
+	case Table:
+		for i := range el.Rows {
+			for j := range el.Rows[i].Cells {
+				el.Rows[i].Cells[j].Items = dropNil(el.Rows[i].Cells[j].Items)
+			}
+		}
+		return el
+	case ActionSet:
+		actions := el.Actions[:0:0]
+		for i, a := range el.Actions {
+			if a, ok := downgradeAction(fmt.Sprintf("%s.actions[%d]", path, i), a, version, record); ok {
+				actions = append(actions, a)
+			}
+		}
+		el.Actions = actions
+		return el
+	}
+	return el
+}
+
+// replaceElement returns the fallback or closest supported equivalent of
+// el, whose type version does not support, or nil to drop it.
+func replaceElement(path string, el Element, version string, record func(path, from, to string)) Element {
+	typ := elementType(el)
+	if b, ok := baseOf(el); ok && b.Fallback != nil {
+		var out Element
+		if !b.Fallback.Drop && b.Fallback.Element != nil {
+			out = transformElement(b.Fallback.Element, path+".fallback", func(path string, el Element) Element {
+				return downgradeElement(path, el, version, record)
+			})
+		}
+		if out == nil {
+			record(path, typ, "")
+			return nil
+		}
+		record(path, typ, elementType(out))
+		return out
+	}
+
+	var out Element
+	switch el := el.(type) {
+	case Table:
+		out = tableToColumnSets(el)
+	case RichTextBlock:
+		out = NewTextBlock(richTextMarkdown(el))
+	case Icon:
+		emoji, ok := iconEmoji[el.Name]
+		if !ok {
+			emoji = "•"
+		}
+		tb := NewTextBlock(emoji)
+		tb.WithColor(el.Color)
+		out = tb
+	case Badge:
+		tb := NewTextBlock("**" + el.Text + "**")
+		tb.WithColor(badgeColors[el.Style])
+		if el.Style == "Subtle" {
+			tb.WithSubtle()
+		}
+		out = tb
+	case ProgressBar:
+		tb := NewTextBlock(ProgressText(el.ratio()))
+		tb.WithColor(el.Color)
+		out = tb
+	case Media:
+		if len(el.Sources) > 0 {
+			out = NewTextBlock(fmt.Sprintf("[▶ Play media](%s)", el.Sources[0].URL))
+		}
+	}
+
+	if out == nil {
+		record(path, typ, "")
+		return nil
+	}
+	record(path, typ, elementType(out))
+	return out
+}
+
+// downgradeAction returns a supported replacement for a, or false to drop it.
+func downgradeAction(path string, orig ActionElement, version string, record func(path, from, to string)) (ActionElement, bool) {
+	a := orig.flat()
+	if !supportsType(version, a.Type) {
+		if a.Type != "Action.Execute" {
+			record(path, a.Type, "")
+			return orig, false
+		}
+		record(path, a.Type, "Action.Submit")
+		data := map[string]any{"verb": a.Verb}
+		if a.Data != nil {
+			data["data"] = a.Data
+		}
+		a.Type, a.Verb, a.Data = "Action.Submit", "", data
+	}
+	stripProperties(path, a.Type, reflect.ValueOf(&a).Elem(), version, record)
+	if a.Card != nil {
+		card := *a.Card
+		for _, ch := range card.DowngradeTo(version) {
+			ch.Path = path + ".card" + strings.TrimPrefix(ch.Path, "$")
+			record(ch.Path, ch.From, ch.To)
+		}
+		if a.Card.Version == "" {
+			card.Version = ""
+		}
+		a.Card = &card
+	}
+	return replaceAction(orig, a), true
+}
+
+// stripProperties clears the properties of v, a struct of type typ, that
+// propertyVersions dates after version. It descends into nested objects
+// such as columns, rows and input choices, and downgrades the actions of
+// selectAction properties. Slices are copied before they are modified.
+func stripProperties(path, typ string, v reflect.Value, version string, record func(path, from, to string)) {
+	for _, field := range reflect.VisibleFields(v.Type()) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fv := v.FieldByIndex(field.Index)
+		if fv.IsZero() {
+			continue
+		}
+		if since, ok := propertySince(typ, name); ok && compareVersions(version, since) < 0 {
+			fv.SetZero()
+			from := typ + "." + name
+			if strings.HasPrefix(typ, "Action.") {
+				from = "Action." + name
+			}
+			record(path, from, "")
+			continue
+		}
+		if sel, ok := fv.Interface().(*Action); ok {
+			if a, ok := downgradeAction(path+"."+name, *sel, version, record); ok {
+				flat := a.flat()
+				fv.Set(reflect.ValueOf(&flat))
+			} else {
+				fv.SetZero()
+			}
+			continue
+		}
+		if fv.Kind() != reflect.Slice || fv.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		items := reflect.MakeSlice(fv.Type(), fv.Len(), fv.Len())
+		reflect.Copy(items, fv)
+		for i := range items.Len() {
+			item := items.Index(i)
+			itemType := typ + "." + name
+			if t := item.FieldByName("Type"); t.IsValid() && t.Kind() == reflect.String && t.String() != "" {
+				itemType = t.String()
+			}
+			stripProperties(fmt.Sprintf("%s.%s[%d]", path, name, i), itemType, item, version, record)
+		}
+		fv.Set(items)
+	}
+}
+
+// tableToColumnSets lays a table out as one ColumnSet per row inside a
+// Container; header rows are kept as the first ColumnSet.
+func tableToColumnSets(t Table) Container {
+	grid := NewContainer()
+	for i, r := range t.Rows {
+		set := NewColumnSet()
+		if i > 0 && boolOr(t.ShowGridLines, true) {
+			set.WithSeparator()
+		}
+		for j, cell := range r.Cells {
+			col := NewColumn(dropNil(cell.Items)...)
+			col.WithWidth("stretch")
+			if j < len(t.Columns) && t.Columns[j].Width > 0 {
+				col.WithWidth(t.Columns[j].Width)
+			}
+			set.AddColumn(col)
+		}
+		grid.AddItem(set)
+	}
+	return grid
+}
+
+// richTextMarkdown flattens text runs into TextBlock markdown, keeping bold,
+// italic and links.
+func richTextMarkdown(r RichTextBlock) string {
+	var b strings.Builder
+	for _, run := range r.Inlines {
+		text := run.Text
+		if run.SelectAction != nil && run.SelectAction.Type == "Action.OpenUrl" {
+			text = fmt.Sprintf("[%s](%s)", text, run.SelectAction.Url)
+		}
+		if run.Italic {
+			text = emphasize(text, "_")
+		}
+		if run.Weight == WeightBolder {
+			text = emphasize(text, "**")
+		}
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+// emphasize wraps text in marker, keeping surrounding spaces outside the
+// markers so the markdown still parses.
+func emphasize(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+func dropNil(elements []Element) []Element {
+	if elements == nil {
+		return nil
+	}
+	out := elements[:0:0]
+	for _, el := range elements {
+		if el != nil {
+			out = append(out, el)
+		}
+	}
+	return out
+}
@@ -0,0 +1,114 @@
+package adaptivecard
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func loadCard(t *testing.T, path string) AdaptiveCard {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := ParseCard(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return card
+}
+
+func TestDowngradeToValidatesForVersion(t *testing.T) {
+	for _, version := range SchemaVersions {
+		t.Run(version, func(t *testing.T) {
+			card := loadCard(t, filepath.Join("testdata", "downgrade", "kitchensink.json"))
+			card.DowngradeTo(version)
+			if card.Version != version {
+				t.Errorf("Version = %q, want %q", card.Version, version)
+			}
+			for _, e := range card.ValidateForVersion(version) {
+				t.Errorf("ValidateForVersion: %v", e)
+			}
+			for _, e := range card.Validate() {
+				t.Errorf("Validate: %v", e)
+			}
+		})
+	}
+}
+
+func TestDowngradeToChanges(t *testing.T) {
+	tests := []struct {
+		version string
+		want    Change
+	}{
+		{"1.5", Change{Path: "$.body[0]", From: "Container.targetWidth"}},
+		{"1.5", Change{Path: "$.body[2]", From: "Media.captionSources"}},
+		{"1.5", Change{Path: "$.body[3]", From: "Input.ChoiceSet.choices.data"}},
+		{"1.4", Change{Path: "$.body[0].selectAction", From: "Action.mode"}},
+		{"1.4", Change{Path: "$.actions[0]", From: "Action.tooltip"}},
+		{"1.4", Change{Path: "$.body[6]", From: "Badge", To: "TextBlock"}},
+		{"1.3", Change{Path: "$.actions[0]", From: "Action.Execute", To: "Action.Submit"}},
+		{"1.2", Change{Path: "$.body[4]", From: "Input.Date.label"}},
+		{"1.1", Change{Path: "$.body[1].columns[0].selectAction", From: "Action.style"}},
+		{"1.0", Change{Path: "$.body[1].columns[0]", From: "Column.selectAction"}},
+		{"1.0", Change{Path: "$", From: "AdaptiveCard.selectAction"}},
+		{"1.0", Change{Path: "$.actions[1].card.actions[0]", From: "Action.Execute", To: "Action.Submit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.want.String(), func(t *testing.T) {
+			card := loadCard(t, filepath.Join("testdata", "downgrade", "kitchensink.json"))
+			changes := card.DowngradeTo(tt.version)
+			if !slices.Contains(changes, tt.want) {
+				t.Errorf("changes do not include %v:\n%v", tt.want, changes)
+			}
+		})
+	}
+}
+
+func TestDowngradeBadgeColor(t *testing.T) {
+	tests := []struct {
+		style  string
+		color  Color
+		subtle bool
+	}{
+		{"Default", ColorDefault, false},
+		{"Subtle", ColorDefault, true},
+		{"Informative", ColorAccent, false},
+		{"Accent", ColorAccent, false},
+		{"Good", ColorGood, false},
+		{"Warning", ColorWarning, false},
+		{"Attention", ColorAttention, false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			badge := NewBadge("x")
+			badge.WithStyle(tt.style)
+			card := newTestCard(badge)
+			card.DowngradeTo("1.4")
+			tb, ok := card.Body[0].(TextBlock)
+			if !ok {
+				t.Fatalf("body[0] = %T, want TextBlock", card.Body[0])
+			}
+			if tb.Color != tt.color || (tb.IsSubtle != nil) != tt.subtle {
+				t.Errorf("color %q, subtle %v; want %q, %v", tb.Color, tb.IsSubtle != nil, tt.color, tt.subtle)
+			}
+			if !tb.Color.Valid() {
+				t.Errorf("color %q is not valid", tb.Color)
+			}
+		})
+	}
+}
+
+func TestDowngradeToDoesNotModifySharedColumns(t *testing.T) {
+	col := NewColumn(NewTextBlock("x"))
+	col.WithSelectAction(NewOpenUrlAction("open", "https://example.com"))
+	set := NewColumnSet(col)
+	card := newTestCard(set)
+	card.DowngradeTo("1.0")
+	if set.Columns[0].SelectAction == nil {
+		t.Error("DowngradeTo cleared the selectAction of the caller's column")
+	}
+}
@@ -0,0 +1,115 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxDumpText = 40
+
+// String returns DumpTree, so cards print readably in logs and test
+// failures.
+func (c AdaptiveCard) String() string {
+	return c.DumpTree()
+}
+
+// DumpTree renders the card as an indented outline, one element per line:
+//
+//	AdaptiveCard 1.5
+//	  Container #summary (emphasis)
+//	    TextBlock "🚨 ECR scan found 3 critical…"
+//	    FactSet(4 facts)
+//	  actions:
+//	    Action.OpenUrl "Open report"
+func (c AdaptiveCard) DumpTree() string {
+	var b strings.Builder
+	dumpCard(&b, c, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func dumpCard(b *strings.Builder, c AdaptiveCard, indent int) {
+	line(b, indent, "AdaptiveCard "+c.Version)
+	walkDepth(c.Body, "$.body", indent+1, func(_ string, depth int, el Element) {
+		line(b, depth, describe(el))
+	})
+	if len(c.Actions) == 0 {
+		return
+	}
+	line(b, indent+1, "actions:")
+	for _, a := range flatActions(c.Actions) {
+		line(b, indent+2, a.Type+" "+quote(a.Title))
+		if a.Card != nil {
+			dumpCard(b, *a.Card, indent+3)
+		}
+	}
+}
+
+func line(b *strings.Builder, indent int, s string) {
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString(s)
+	b.WriteByte('\n')
+}
+
+// describe returns a one-line summary of el, without its children.
+func describe(el Element) string {
+	switch el := el.(type) {
+	case TextBlock:
+		return "TextBlock " + withID(quote(el.Text), el.ID)
+	case RichTextBlock:
+		var text strings.Builder
+		for _, run := range el.Inlines {
+			text.WriteString(run.Text)
+		}
+		return "RichTextBlock " + quote(text.String())
+	case Container:
+		s := withID("Container", el.ID)
+		if el.Style != "" {
+			s += " (" + string(el.Style) + ")"
+		}
+		return s
+	case ColumnSet:
+		return withID(fmt.Sprintf("ColumnSet(%s)", plural(len(el.Columns), "column")), el.ID)
+	case FactSet:
+		return fmt.Sprintf("FactSet(%s)", plural(len(el.Facts), "fact"))
+	case Table:
+		return fmt.Sprintf("Table(%s × %s)", plural(len(el.Rows), "row"), plural(len(el.Columns), "column"))
+	case Image:
+		if el.AltText != "" {
+			return "Image " + quote(el.AltText)
+		}
+		return "Image " + quote(el.URL)
+	case Media:
+		return fmt.Sprintf("Media(%s)", plural(len(el.Sources), "source"))
+	case Icon:
+		return "Icon " + string(el.Name)
+	case Badge:
+		return "Badge " + quote(el.Text)
+	case ProgressBar:
+		return withID(fmt.Sprintf("ProgressBar(%g/%g)", el.Value, el.Max), el.ID)
+	case ActionSet:
+		return withID(fmt.Sprintf("ActionSet(%s)", plural(len(el.Actions), "action")), el.ID)
+	case ChoiceSetInput:
+		return withID(fmt.Sprintf("Input.ChoiceSet(%s)", plural(len(el.Choices), "choice")), el.ID)
+	}
+	if in, ok := inputFields(el); ok {
+		return withID(elementType(el), in.ID)
+	}
+	return elementType(el)
+}
+
+func withID(s, id string) string {
+	if id == "" {
+		return s
+	}
+	return s + " #" + id
+}
+
+// quote quotes s, shortened to maxDumpText characters.
+func quote(s string) string {
+	if utf8.RuneCountInString(s) > maxDumpText {
+		s = string([]rune(s)[:maxDumpText-1]) + "…"
+	}
+	return strconv.Quote(s)
+}
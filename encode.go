@@ -0,0 +1,20 @@
+//go:build !goexperiment.jsonv2 || !go1.27
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Encode writes the card's JSON to w. Building with GOEXPERIMENT=jsonv2
+// switches it to a streaming encoder that is faster for large tables; the
+// output is the same either way.
+func (c AdaptiveCard) Encode(w io.Writer) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,20 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package adaptivecard
+
+import (
+	jsonv1 "encoding/json"
+	jsonv2 "encoding/json/v2"
+	"io"
+)
+
+// Encode streams the card's JSON to w with encoding/json/v2, which writes
+// tokens straight to w instead of building the document in memory. The v1
+// options keep the output byte-for-byte identical to MarshalJSON.
+func (c AdaptiveCard) Encode(w io.Writer) error {
+	raw, err := c.raw()
+	if err != nil {
+		return err
+	}
+	return jsonv2.MarshalWrite(w, extraOf(raw, raw.extra), jsonv1.DefaultOptionsV1())
+}
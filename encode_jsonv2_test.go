@@ -0,0 +1,61 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package adaptivecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestEncodeMatchesMarshal checks that the encoding/json/v2 Encode writes
+// the same bytes as json.Marshal for every card fixture.
+func TestEncodeMatchesMarshal(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*", "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no card fixtures: %v", err)
+	}
+	cards := map[string]AdaptiveCard{}
+	for _, file := range files {
+		in, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		card, err := ParseCard(in)
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		cards[file] = card
+	}
+
+	table := NewTable()
+	table.AddHeaderRow("Name", "Notes")
+	for i := range 500 {
+		table.AddRow(
+			NewTableCell(NewTextBlock(fmt.Sprintf("row %d", i))),
+			NewTableCell(NewTextBlock("<at>Ops</at> & \"friends\"")),
+		)
+	}
+	large := NewAdaptiveCard("1.5")
+	large.AddBody(table)
+	cards["large table"] = large
+
+	for name, card := range cards {
+		t.Run(name, func(t *testing.T) {
+			want, err := json.Marshal(card)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got bytes.Buffer
+			if err := card.Encode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("Encode differs from json.Marshal\n got: %s\nwant: %s", got.Bytes(), want)
+			}
+		})
+	}
+}
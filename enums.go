@@ -0,0 +1,20 @@
+package adaptivecard
+
+import "strings"
+
+// The enum types (Weight, Size, Color, Spacing and ContainerStyle) and their
+// constants are generated into schema_gen.go from the typed schema.
+
+// validEnum reports whether v is empty or equal to one of values, ignoring
+// case.
+func validEnum[T ~string](v T, values ...T) bool {
+	if v == "" {
+		return true
+	}
+	for _, known := range values {
+		if strings.EqualFold(string(v), string(known)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package adaptivecard
+
+import "strings"
+
+// AutoFallbackText returns middleware that fills in an empty FallbackText
+// with the card's visible text — text blocks, text runs, facts and badges —
+// one element per line, so notifications and clients that cannot render the
+// card still show something readable. Text longer than maxLen runes is cut
+// with an ellipsis; maxLen 0 means no limit.
+func AutoFallbackText(maxLen int) Middleware {
+	return func(c *AdaptiveCard) error {
+		if c.FallbackText == "" {
+			c.FallbackText = truncateText(bodyText(c.Body), maxLen)
+		}
+		return nil
+	}
+}
+
+// bodyText joins the visible text of elements, one element per line. Fact
+// titles and values are joined with ": " and text runs are concatenated.
+func bodyText(elements []Element) string {
+	var lines []string
+	add := func(text string) {
+		if text = strings.TrimSpace(text); text != "" {
+			lines = append(lines, text)
+		}
+	}
+	_ = walk(resolveConditionals(elements), "$", func(path string, el Element) error {
+		switch el := el.(type) {
+		case FactSet:
+			for _, f := range el.Facts {
+				add(f.Title + ": " + f.Value)
+			}
+		case RichTextBlock:
+			var sb strings.Builder
+			for _, run := range el.Inlines {
+				sb.WriteString(run.Text)
+			}
+			add(sb.String())
+		default:
+			elementTexts(path, el, func(_, text string) { add(text) })
+		}
+		return nil
+	})
+	return strings.Join(lines, "\n")
+}
+
+func truncateText(s string, maxLen int) string {
+	r := []rune(s)
+	if maxLen <= 0 || len(r) <= maxLen {
+		return s
+	}
+	return string(r[:maxLen-1]) + "…"
+}
@@ -0,0 +1,100 @@
+package adaptivecard
+
+//go:generate go run ./internal/cmd/genschema -schema internal/cmd/genschema/schema -out schema_gen.go -enums FontWeight=Weight,FontSize=Size,Colors=Color,Spacing=Spacing,ContainerStyle=ContainerStyle
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaVersions are the Adaptive Card schema versions the package can emit.
+var SchemaVersions = []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"}
+
+// Feature describes one element or action type and the properties this
+// package can set on it.
+type Feature struct {
+	Type       string     `json:"type"`
+	Since      string     `json:"since"`
+	Properties []Property `json:"properties"`
+}
+
+type Property struct {
+	Name  string `json:"name"`
+	Since string `json:"since"`
+}
+
+// Manifest is the machine-readable list of what the package supports, for
+// platform teams that gate templates on library capability.
+type Manifest struct {
+	Versions []string  `json:"versions"`
+	Card     Feature   `json:"card"`
+	Elements []Feature `json:"elements"`
+	Actions  []Feature `json:"actions"`
+}
+
+// SupportedFeatures reports the schema versions, elements, actions and
+// properties supported by this version of the package. Properties are read
+// from the Go types, so the manifest cannot drift from the code.
+func SupportedFeatures() Manifest {
+	m := Manifest{
+		Versions: SchemaVersions,
+		Card:     feature("AdaptiveCard", reflect.TypeOf(AdaptiveCard{})),
+	}
+	for _, el := range []Element{
+		TextBlock{}, Container{}, ColumnSet{}, FactSet{}, Table{}, Image{}, Media{},
+		RichTextBlock{}, Icon{}, Badge{}, ProgressBar{}, ChoiceSetInput{}, DateInput{}, TimeInput{}, NumberInput{}, ActionSet{},
+	} {
+		m.Elements = append(m.Elements, feature(elementType(el), reflect.TypeOf(el)))
+	}
+	for _, a := range []ActionElement{
+		NewOpenUrlAction("", ""), NewSubmitAction("", nil), NewShowCardAction("", AdaptiveCard{}),
+		NewToggleVisibilityAction(""), NewExecuteAction("", "", nil),
+	} {
+		m.Actions = append(m.Actions, feature(a.flat().Type, reflect.TypeOf(a)))
+	}
+	sort.Slice(m.Elements, func(i, j int) bool { return m.Elements[i].Type < m.Elements[j].Type })
+	return m
+}
+
+// elementType returns the "type" discriminator of el's Go type.
+func elementType(el Element) string {
+	switch el := el.(type) {
+	case ChoiceSetInput:
+		return "Input.ChoiceSet"
+	case DateInput:
+		return "Input.Date"
+	case TimeInput:
+		return "Input.Time"
+	case NumberInput:
+		return "Input.Number"
+	case RawElement:
+		return el.Type
+	}
+	elementTypesMu.RLock()
+	typ, ok := customTypes[reflect.TypeOf(el)]
+	elementTypesMu.RUnlock()
+	if ok {
+		return typ
+	}
+	return reflect.TypeOf(el).Name()
+}
+
+func feature(typ string, t reflect.Type) Feature {
+	f := Feature{Type: typ, Since: "1.0"}
+	if v, ok := typeVersions[typ]; ok {
+		f.Since = v
+	}
+	for _, field := range reflect.VisibleFields(t) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous || name == "" || name == "-" || name == "type" {
+			continue
+		}
+		since := f.Since
+		if v, ok := propertySince(typ, name); ok && compareVersions(v, since) > 0 {
+			since = v
+		}
+		f.Properties = append(f.Properties, Property{Name: name, Since: since})
+	}
+	return f
+}
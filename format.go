@@ -0,0 +1,58 @@
+package adaptivecard
+
+import (
+	"sort"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// leadingKeys are emitted first, in this order, by Format; all other keys
+// follow alphabetically.
+var leadingKeys = []string{"type", "$schema", "version", "id"}
+
+// Format parses card JSON and re-emits it in a canonical layout: two-space
+// indentation, "type" (then "$schema", "version" and "id") first in every
+// object followed by the remaining keys in alphabetical order, no HTML
+// escaping and a trailing newline. Formatting is idempotent, so card files
+// checked into a repository only change where their content does.
+func Format(data []byte) ([]byte, error) {
+	tree, err := orderedjson.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	out, err := orderedjson.MarshalIndent(canonical(tree), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+func canonical(v any) any {
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		for i := range v.Members {
+			v.Members[i].Value = canonical(v.Members[i].Value)
+		}
+		sort.SliceStable(v.Members, func(i, j int) bool {
+			ri, rj := keyRank(v.Members[i].Key), keyRank(v.Members[j].Key)
+			if ri != rj {
+				return ri < rj
+			}
+			return v.Members[i].Key < v.Members[j].Key
+		})
+	case []any:
+		for i := range v {
+			v[i] = canonical(v[i])
+		}
+	}
+	return v
+}
+
+func keyRank(key string) int {
+	for i, k := range leadingKeys {
+		if k == key {
+			return i
+		}
+	}
+	return len(leadingKeys)
+}
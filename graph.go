@@ -0,0 +1,59 @@
+package adaptivecard
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// GraphChatMessage is the Microsoft Graph chatMessage body for
+// POST /chats/{id}/messages and /teams/{id}/channels/{id}/messages.
+type GraphChatMessage struct {
+	Subject     string            `json:"subject,omitempty"`
+	Body        GraphItemBody     `json:"body"`
+	Attachments []GraphAttachment `json:"attachments"`
+}
+
+// GraphItemBody is the message text. Cards are placed in it with
+// <attachment id="..."></attachment> tags, which need ContentType "html".
+type GraphItemBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+// GraphAttachment is a chatMessage attachment. Unlike a Bot Framework
+// Attachment, Graph takes the card as a JSON string in Content.
+type GraphAttachment struct {
+	ID          string  `json:"id"`
+	ContentType string  `json:"contentType"`
+	ContentURL  *string `json:"contentUrl"`
+	Content     string  `json:"content"`
+}
+
+// ToGraphChatMessage returns a Graph chatMessage carrying the card as its
+// only attachment, referenced from the body by an <attachment> placeholder.
+// The attachment ID is derived from the card JSON, so the same card always
+// produces the same message.
+func (c AdaptiveCard) ToGraphChatMessage() (GraphChatMessage, error) {
+	if c.Type == "" {
+		c.Type = "AdaptiveCard"
+	}
+	content, err := json.Marshal(c)
+	if err != nil {
+		return GraphChatMessage{}, fmt.Errorf("adaptivecard: encoding card: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	id := hex.EncodeToString(sum[:16])
+	return GraphChatMessage{
+		Body: GraphItemBody{
+			ContentType: "html",
+			Content:     `<attachment id="` + id + `"></attachment>`,
+		},
+		Attachments: []GraphAttachment{{
+			ID:          id,
+			ContentType: ContentType,
+			Content:     string(content),
+		}},
+	}, nil
+}
@@ -0,0 +1,33 @@
+package adaptivecard
+
+// Grid arranges items into rows of cols equal-width columns, e.g. a
+// dashboard of stat tiles. On narrow cards (targetWidth "atMost:narrow"),
+// where side-by-side columns get cramped, the items are stacked vertically
+// instead. Both layouts are emitted, so items must not carry IDs.
+func Grid(cols int, items ...Element) Container {
+	cols = max(cols, 1)
+
+	wide := NewContainer()
+	wide.WithTargetWidth("atLeast:standard")
+	for start := 0; start < len(items); start += cols {
+		row := NewColumnSet()
+		for j := start; j < start+cols; j++ {
+			col := NewColumn()
+			col.WithWidth("stretch")
+			// Pad the last row so its columns line up with the rows above.
+			if j < len(items) {
+				col.AddItem(items[j])
+			}
+			row.AddColumn(col)
+		}
+		wide.AddItem(row)
+	}
+	if cols == 1 {
+		wide.TargetWidth = ""
+		return wide
+	}
+
+	narrow := NewContainer(items...)
+	narrow.WithTargetWidth("atMost:narrow")
+	return NewContainer(wide, narrow)
+}
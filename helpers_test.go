@@ -0,0 +1,19 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func newTestCard(body ...Element) AdaptiveCard {
+	return AdaptiveCard{Type: "AdaptiveCard", Version: "1.5", Body: body}
+}
+
+func mustMarshal(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return string(data)
+}
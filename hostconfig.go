@@ -0,0 +1,223 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// HostConfig is the subset of an Adaptive Cards host config that affects how
+// text and containers look: font sizes and weights, spacing and the colors of
+// each container style. Parse one exported from the target host so previews
+// match its styling.
+type HostConfig struct {
+	FontFamily      string                        `json:"fontFamily,omitempty"`
+	FontSizes       FontSizes                     `json:"fontSizes"`
+	FontWeights     FontWeights                   `json:"fontWeights"`
+	FontTypes       map[string]FontType           `json:"fontTypes,omitempty"`
+	Spacing         SpacingConfig                 `json:"spacing"`
+	Separator       SeparatorConfig               `json:"separator"`
+	ContainerStyles map[string]ContainerStyleConf `json:"containerStyles"`
+}
+
+type FontSizes struct {
+	Small      int `json:"small"`
+	Default    int `json:"default"`
+	Medium     int `json:"medium"`
+	Large      int `json:"large"`
+	ExtraLarge int `json:"extraLarge"`
+}
+
+type FontWeights struct {
+	Lighter int `json:"lighter"`
+	Default int `json:"default"`
+	Bolder  int `json:"bolder"`
+}
+
+// FontType overrides the family, sizes and weights for one font type
+// ("default" or "monospace").
+type FontType struct {
+	FontFamily  string      `json:"fontFamily,omitempty"`
+	FontSizes   FontSizes   `json:"fontSizes"`
+	FontWeights FontWeights `json:"fontWeights"`
+}
+
+type SpacingConfig struct {
+	Small      int `json:"small"`
+	Default    int `json:"default"`
+	Medium     int `json:"medium"`
+	Large      int `json:"large"`
+	ExtraLarge int `json:"extraLarge"`
+	Padding    int `json:"padding"`
+}
+
+type SeparatorConfig struct {
+	LineThickness int    `json:"lineThickness"`
+	LineColor     string `json:"lineColor,omitempty"`
+}
+
+type ContainerStyleConf struct {
+	BackgroundColor  string                     `json:"backgroundColor,omitempty"`
+	ForegroundColors map[string]ForegroundColor `json:"foregroundColors,omitempty"`
+}
+
+// ForegroundColor holds the normal and subtle variant of one text color.
+type ForegroundColor struct {
+	Default string `json:"default"`
+	Subtle  string `json:"subtle,omitempty"`
+}
+
+// DefaultHostConfig returns values matching the Teams light theme, used for
+// anything a parsed config leaves unset.
+func DefaultHostConfig() HostConfig {
+	return HostConfig{
+		FontFamily:  "Segoe UI, system-ui, sans-serif",
+		FontSizes:   FontSizes{Small: 12, Default: 14, Medium: 14, Large: 18, ExtraLarge: 24},
+		FontWeights: FontWeights{Lighter: 200, Default: 400, Bolder: 600},
+		Spacing:     SpacingConfig{Small: 4, Default: 8, Medium: 16, Large: 20, ExtraLarge: 24, Padding: 16},
+		Separator:   SeparatorConfig{LineThickness: 1, LineColor: "#EEEEEE"},
+		ContainerStyles: map[string]ContainerStyleConf{
+			"default": {
+				BackgroundColor: "#FFFFFF",
+				ForegroundColors: map[string]ForegroundColor{
+					"default":   {Default: "#242424", Subtle: "#616161"},
+					"dark":      {Default: "#242424", Subtle: "#616161"},
+					"light":     {Default: "#FFFFFF", Subtle: "#F5F5F5"},
+					"accent":    {Default: "#5B5FC7", Subtle: "#6264A7"},
+					"good":      {Default: "#237B4B", Subtle: "#3A8B5F"},
+					"warning":   {Default: "#835B00", Subtle: "#9A6B00"},
+					"attention": {Default: "#C4314B", Subtle: "#D13438"},
+				},
+			},
+			"emphasis":  {BackgroundColor: "#F5F5F5"},
+			"accent":    {BackgroundColor: "#E8EBFA"},
+			"good":      {BackgroundColor: "#E7F2DA"},
+			"warning":   {BackgroundColor: "#FBF6D9"},
+			"attention": {BackgroundColor: "#FCF4F6"},
+		},
+	}
+}
+
+// ParseHostConfig decodes a host config JSON document. Values the document
+// omits keep their DefaultHostConfig value.
+func ParseHostConfig(data []byte) (HostConfig, error) {
+	cfg := DefaultHostConfig()
+	styles := cfg.ContainerStyles
+	cfg.ContainerStyles = nil
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return HostConfig{}, err
+	}
+	for name, def := range styles {
+		style, ok := cfg.ContainerStyles[name]
+		if !ok {
+			if cfg.ContainerStyles == nil {
+				cfg.ContainerStyles = map[string]ContainerStyleConf{}
+			}
+			cfg.ContainerStyles[name] = def
+			continue
+		}
+		if style.BackgroundColor == "" {
+			style.BackgroundColor = def.BackgroundColor
+		}
+		cfg.ContainerStyles[name] = style
+	}
+	return cfg, nil
+}
+
+// FontSize resolves a TextBlock size ("small", "medium", ...) to pixels for
+// the given font type; unknown sizes resolve to the default size.
+func (h HostConfig) FontSize(fontType string, size Size) int {
+	sizes := h.FontSizes
+	if ft, ok := h.FontTypes[fontType]; ok && ft.FontSizes.Default != 0 {
+		sizes = ft.FontSizes
+	}
+	switch strings.ToLower(string(size)) {
+	case "small":
+		return sizes.Small
+	case "medium":
+		return sizes.Medium
+	case "large":
+		return sizes.Large
+	case "extralarge":
+		return sizes.ExtraLarge
+	}
+	return sizes.Default
+}
+
+// FontWeight resolves "lighter", "default" or "bolder" to a CSS font weight.
+func (h HostConfig) FontWeight(fontType string, weight Weight) int {
+	weights := h.FontWeights
+	if ft, ok := h.FontTypes[fontType]; ok && ft.FontWeights.Default != 0 {
+		weights = ft.FontWeights
+	}
+	switch strings.ToLower(string(weight)) {
+	case "lighter":
+		return weights.Lighter
+	case "bolder":
+		return weights.Bolder
+	}
+	return weights.Default
+}
+
+// FontFamilyFor resolves the CSS font family for a font type.
+func (h HostConfig) FontFamilyFor(fontType string) string {
+	if ft, ok := h.FontTypes[fontType]; ok && ft.FontFamily != "" {
+		return ft.FontFamily
+	}
+	return h.FontFamily
+}
+
+// SpacingFor resolves an element spacing ("none", "small", ..., "padding")
+// to pixels.
+func (h HostConfig) SpacingFor(spacing Spacing) int {
+	switch strings.ToLower(string(spacing)) {
+	case "none":
+		return 0
+	case "small":
+		return h.Spacing.Small
+	case "medium":
+		return h.Spacing.Medium
+	case "large":
+		return h.Spacing.Large
+	case "extralarge":
+		return h.Spacing.ExtraLarge
+	case "padding":
+		return h.Spacing.Padding
+	}
+	return h.Spacing.Default
+}
+
+// BackgroundColor resolves the background of a container style, falling back
+// to the default style.
+func (h HostConfig) BackgroundColor(containerStyle ContainerStyle) string {
+	if s, ok := h.ContainerStyles[string(containerStyle)]; ok && s.BackgroundColor != "" {
+		return s.BackgroundColor
+	}
+	return h.ContainerStyles["default"].BackgroundColor
+}
+
+// ForegroundColor resolves a text color inside a container style. Styles
+// without their own foreground colors inherit the default style's, as hosts
+// do.
+func (h HostConfig) ForegroundColor(containerStyle ContainerStyle, color Color, subtle bool) string {
+	if color == "" {
+		color = ColorDefault
+	}
+	lookup := func(style ContainerStyle) (string, bool) {
+		fc, ok := h.ContainerStyles[string(style)].ForegroundColors[string(color)]
+		if !ok {
+			fc, ok = h.ContainerStyles[string(style)].ForegroundColors["default"]
+		}
+		if !ok {
+			return "", false
+		}
+		if subtle && fc.Subtle != "" {
+			return fc.Subtle, true
+		}
+		return fc.Default, true
+	}
+	if c, ok := lookup(containerStyle); ok {
+		return c
+	}
+	c, _ := lookup(ContainerStyleDefault)
+	return c
+}
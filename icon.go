@@ -0,0 +1,62 @@
+package adaptivecard
+
+//go:generate go run ./internal/cmd/genicons -in internal/cmd/genicons/icons.txt -out icon_names.go
+
+// IconName is the name of a Fluent UI System Icon. The Icon* constants list
+// the names known to render in Teams. Other names are marshaled as given,
+// since the Fluent set is larger than the catalog; Validate reports them
+// under RuleIcon because unknown names show up as a blank square.
+type IconName string
+
+// Valid reports whether n is in the generated icon catalog. A name that is
+// not may still be a valid Fluent icon.
+func (n IconName) Valid() bool {
+	_, ok := iconNames[n]
+	return ok
+}
+
+// ----------------------
+// Icon
+// ----------------------
+type Icon struct {
+	Type string `json:"type"`
+	BaseElement
+	Name         IconName `json:"name"`
+	Size         string   `json:"size,omitempty"`
+	Style        string   `json:"style,omitempty"`
+	Color        Color    `json:"color,omitempty"`
+	SelectAction *Action  `json:"selectAction,omitempty"`
+}
+
+func NewIcon(name IconName, opts ...IconOption) Icon {
+	i := Icon{
+		Type: "Icon",
+		Name: name,
+	}
+	for _, opt := range opts {
+		opt.applyIcon(&i)
+	}
+	return i
+}
+func (Icon) isElement() {}
+func (i Icon) toRaw() any {
+	return i
+}
+
+func (i *Icon) WithSize(size string) {
+	i.Size = size
+}
+
+// WithStyle selects the "Regular" or "Filled" variant of the icon.
+func (i *Icon) WithStyle(style string) {
+	i.Style = style
+}
+
+func (i *Icon) WithColor(color Color) {
+	i.Color = color
+}
+
+func (i *Icon) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	i.SelectAction = &a
+}
@@ -0,0 +1,277 @@
+// Code generated by genicons from internal/cmd/genicons/icons.txt; DO NOT EDIT.
+
+package adaptivecard
+
+const (
+	IconAccessibility         IconName = "Accessibility"
+	IconAdd                   IconName = "Add"
+	IconAddCircle             IconName = "AddCircle"
+	IconAlert                 IconName = "Alert"
+	IconAlertOff              IconName = "AlertOff"
+	IconAlertOn               IconName = "AlertOn"
+	IconAlertUrgent           IconName = "AlertUrgent"
+	IconAppFolder             IconName = "AppFolder"
+	IconApps                  IconName = "Apps"
+	IconArchive               IconName = "Archive"
+	IconArrowClockwise        IconName = "ArrowClockwise"
+	IconArrowCounterclockwise IconName = "ArrowCounterclockwise"
+	IconArrowDown             IconName = "ArrowDown"
+	IconArrowDownload         IconName = "ArrowDownload"
+	IconArrowExit             IconName = "ArrowExit"
+	IconArrowForward          IconName = "ArrowForward"
+	IconArrowLeft             IconName = "ArrowLeft"
+	IconArrowReply            IconName = "ArrowReply"
+	IconArrowRight            IconName = "ArrowRight"
+	IconArrowSync             IconName = "ArrowSync"
+	IconArrowTrending         IconName = "ArrowTrending"
+	IconArrowUp               IconName = "ArrowUp"
+	IconArrowUpload           IconName = "ArrowUpload"
+	IconAttach                IconName = "Attach"
+	IconBeaker                IconName = "Beaker"
+	IconBookmark              IconName = "Bookmark"
+	IconBot                   IconName = "Bot"
+	IconBox                   IconName = "Box"
+	IconBriefcase             IconName = "Briefcase"
+	IconBug                   IconName = "Bug"
+	IconBuilding              IconName = "Building"
+	IconCalendar              IconName = "Calendar"
+	IconCalendarLtr           IconName = "CalendarLtr"
+	IconCall                  IconName = "Call"
+	IconCamera                IconName = "Camera"
+	IconCart                  IconName = "Cart"
+	IconChat                  IconName = "Chat"
+	IconChatMultiple          IconName = "ChatMultiple"
+	IconCheckmark             IconName = "Checkmark"
+	IconCheckmarkCircle       IconName = "CheckmarkCircle"
+	IconCheckmarkStarburst    IconName = "CheckmarkStarburst"
+	IconChevronDown           IconName = "ChevronDown"
+	IconChevronLeft           IconName = "ChevronLeft"
+	IconChevronRight          IconName = "ChevronRight"
+	IconChevronUp             IconName = "ChevronUp"
+	IconCircle                IconName = "Circle"
+	IconClipboard             IconName = "Clipboard"
+	IconClipboardTask         IconName = "ClipboardTask"
+	IconClock                 IconName = "Clock"
+	IconCloud                 IconName = "Cloud"
+	IconCode                  IconName = "Code"
+	IconComment               IconName = "Comment"
+	IconCopy                  IconName = "Copy"
+	IconDataBarVertical       IconName = "DataBarVertical"
+	IconDataPie               IconName = "DataPie"
+	IconDataTrending          IconName = "DataTrending"
+	IconDatabase              IconName = "Database"
+	IconDelete                IconName = "Delete"
+	IconDesktop               IconName = "Desktop"
+	IconDismiss               IconName = "Dismiss"
+	IconDismissCircle         IconName = "DismissCircle"
+	IconDocument              IconName = "Document"
+	IconDocumentText          IconName = "DocumentText"
+	IconEdit                  IconName = "Edit"
+	IconEmoji                 IconName = "Emoji"
+	IconErrorCircle           IconName = "ErrorCircle"
+	IconEye                   IconName = "Eye"
+	IconEyeOff                IconName = "EyeOff"
+	IconFilter                IconName = "Filter"
+	IconFlag                  IconName = "Flag"
+	IconFolder                IconName = "Folder"
+	IconFolderOpen            IconName = "FolderOpen"
+	IconGift                  IconName = "Gift"
+	IconGlobe                 IconName = "Globe"
+	IconHeart                 IconName = "Heart"
+	IconHistory               IconName = "History"
+	IconHome                  IconName = "Home"
+	IconImage                 IconName = "Image"
+	IconInfo                  IconName = "Info"
+	IconKey                   IconName = "Key"
+	IconLaptop                IconName = "Laptop"
+	IconLibrary               IconName = "Library"
+	IconLightbulb             IconName = "Lightbulb"
+	IconLink                  IconName = "Link"
+	IconList                  IconName = "List"
+	IconLocation              IconName = "Location"
+	IconLockClosed            IconName = "LockClosed"
+	IconLockOpen              IconName = "LockOpen"
+	IconMail                  IconName = "Mail"
+	IconMap                   IconName = "Map"
+	IconMegaphone             IconName = "Megaphone"
+	IconMic                   IconName = "Mic"
+	IconMoney                 IconName = "Money"
+	IconMoreHorizontal        IconName = "MoreHorizontal"
+	IconMoreVertical          IconName = "MoreVertical"
+	IconNote                  IconName = "Note"
+	IconOpen                  IconName = "Open"
+	IconPeople                IconName = "People"
+	IconPeopleTeam            IconName = "PeopleTeam"
+	IconPerson                IconName = "Person"
+	IconPersonAdd             IconName = "PersonAdd"
+	IconPhone                 IconName = "Phone"
+	IconPin                   IconName = "Pin"
+	IconPlay                  IconName = "Play"
+	IconQuestion              IconName = "Question"
+	IconQuestionCircle        IconName = "QuestionCircle"
+	IconReceipt               IconName = "Receipt"
+	IconRocket                IconName = "Rocket"
+	IconSave                  IconName = "Save"
+	IconSearch                IconName = "Search"
+	IconSend                  IconName = "Send"
+	IconServer                IconName = "Server"
+	IconSettings              IconName = "Settings"
+	IconShare                 IconName = "Share"
+	IconShield                IconName = "Shield"
+	IconShieldError           IconName = "ShieldError"
+	IconSparkle               IconName = "Sparkle"
+	IconStar                  IconName = "Star"
+	IconStatus                IconName = "Status"
+	IconStop                  IconName = "Stop"
+	IconTag                   IconName = "Tag"
+	IconTarget                IconName = "Target"
+	IconTaskListLtr           IconName = "TaskListLtr"
+	IconThumbDislike          IconName = "ThumbDislike"
+	IconThumbLike             IconName = "ThumbLike"
+	IconTimer                 IconName = "Timer"
+	IconToolbox               IconName = "Toolbox"
+	IconTranslate             IconName = "Translate"
+	IconTrophy                IconName = "Trophy"
+	IconVideo                 IconName = "Video"
+	IconWallet                IconName = "Wallet"
+	IconWarning               IconName = "Warning"
+	IconWindow                IconName = "Window"
+	IconWrench                IconName = "Wrench"
+)
+
+var iconNames = map[IconName]struct{}{
+	IconAccessibility:         {},
+	IconAdd:                   {},
+	IconAddCircle:             {},
+	IconAlert:                 {},
+	IconAlertOff:              {},
+	IconAlertOn:               {},
+	IconAlertUrgent:           {},
+	IconAppFolder:             {},
+	IconApps:                  {},
+	IconArchive:               {},
+	IconArrowClockwise:        {},
+	IconArrowCounterclockwise: {},
+	IconArrowDown:             {},
+	IconArrowDownload:         {},
+	IconArrowExit:             {},
+	IconArrowForward:          {},
+	IconArrowLeft:             {},
+	IconArrowReply:            {},
+	IconArrowRight:            {},
+	IconArrowSync:             {},
+	IconArrowTrending:         {},
+	IconArrowUp:               {},
+	IconArrowUpload:           {},
+	IconAttach:                {},
+	IconBeaker:                {},
+	IconBookmark:              {},
+	IconBot:                   {},
+	IconBox:                   {},
+	IconBriefcase:             {},
+	IconBug:                   {},
+	IconBuilding:              {},
+	IconCalendar:              {},
+	IconCalendarLtr:           {},
+	IconCall:                  {},
+	IconCamera:                {},
+	IconCart:                  {},
+	IconChat:                  {},
+	IconChatMultiple:          {},
+	IconCheckmark:             {},
+	IconCheckmarkCircle:       {},
+	IconCheckmarkStarburst:    {},
+	IconChevronDown:           {},
+	IconChevronLeft:           {},
+	IconChevronRight:          {},
+	IconChevronUp:             {},
+	IconCircle:                {},
+	IconClipboard:             {},
+	IconClipboardTask:         {},
+	IconClock:                 {},
+	IconCloud:                 {},
+	IconCode:                  {},
+	IconComment:               {},
+	IconCopy:                  {},
+	IconDataBarVertical:       {},
+	IconDataPie:               {},
+	IconDataTrending:          {},
+	IconDatabase:              {},
+	IconDelete:                {},
+	IconDesktop:               {},
+	IconDismiss:               {},
+	IconDismissCircle:         {},
+	IconDocument:              {},
+	IconDocumentText:          {},
+	IconEdit:                  {},
+	IconEmoji:                 {},
+	IconErrorCircle:           {},
+	IconEye:                   {},
+	IconEyeOff:                {},
+	IconFilter:                {},
+	IconFlag:                  {},
+	IconFolder:                {},
+	IconFolderOpen:            {},
+	IconGift:                  {},
+	IconGlobe:                 {},
+	IconHeart:                 {},
+	IconHistory:               {},
+	IconHome:                  {},
+	IconImage:                 {},
+	IconInfo:                  {},
+	IconKey:                   {},
+	IconLaptop:                {},
+	IconLibrary:               {},
+	IconLightbulb:             {},
+	IconLink:                  {},
+	IconList:                  {},
+	IconLocation:              {},
+	IconLockClosed:            {},
+	IconLockOpen:              {},
+	IconMail:                  {},
+	IconMap:                   {},
+	IconMegaphone:             {},
+	IconMic:                   {},
+	IconMoney:                 {},
+	IconMoreHorizontal:        {},
+	IconMoreVertical:          {},
+	IconNote:                  {},
+	IconOpen:                  {},
+	IconPeople:                {},
+	IconPeopleTeam:            {},
+	IconPerson:                {},
+	IconPersonAdd:             {},
+	IconPhone:                 {},
+	IconPin:                   {},
+	IconPlay:                  {},
+	IconQuestion:              {},
+	IconQuestionCircle:        {},
+	IconReceipt:               {},
+	IconRocket:                {},
+	IconSave:                  {},
+	IconSearch:                {},
+	IconSend:                  {},
+	IconServer:                {},
+	IconSettings:              {},
+	IconShare:                 {},
+	IconShield:                {},
+	IconShieldError:           {},
+	IconSparkle:               {},
+	IconStar:                  {},
+	IconStatus:                {},
+	IconStop:                  {},
+	IconTag:                   {},
+	IconTarget:                {},
+	IconTaskListLtr:           {},
+	IconThumbDislike:          {},
+	IconThumbLike:             {},
+	IconTimer:                 {},
+	IconToolbox:               {},
+	IconTranslate:             {},
+	IconTrophy:                {},
+	IconVideo:                 {},
+	IconWallet:                {},
+	IconWarning:               {},
+	IconWindow:                {},
+	IconWrench:                {},
+}
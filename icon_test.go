@@ -0,0 +1,48 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnknownIconMarshalsAndIsReported(t *testing.T) {
+	badge := NewBadge("new")
+	badge.WithIcon("NotInCatalog", "before")
+	card := newTestCard(NewIcon("AnimalRabbitOff"), badge, NewIcon(IconName(firstCatalogIcon(t))))
+
+	got := mustMarshal(t, card)
+	if !strings.Contains(got, `"AnimalRabbitOff"`) {
+		t.Errorf("icon name missing from %s", got)
+	}
+
+	var paths []string
+	for _, e := range card.Validate() {
+		if e.Rule == RuleIcon {
+			paths = append(paths, e.Path)
+		}
+	}
+	want := []string{"$.body[0].name", "$.body[1].icon"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("RuleIcon paths = %v, want %v", paths, want)
+	}
+}
+
+func TestParsedCardWithUnknownIconRoundTrips(t *testing.T) {
+	in := `{"type":"AdaptiveCard","version":"1.5","body":[{"type":"Icon","name":"AnimalRabbitOff"}],"$schema":""}`
+	card, err := ParseCard([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mustMarshal(t, card); got != in {
+		t.Errorf("round trip:\n got %s\nwant %s", got, in)
+	}
+}
+
+func firstCatalogIcon(t *testing.T) string {
+	t.Helper()
+	for name := range iconNames {
+		return string(name)
+	}
+	t.Fatal("icon catalog is empty")
+	return ""
+}
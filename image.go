@@ -0,0 +1,86 @@
+package adaptivecard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DataURIWarnSize is the encoded size above which ImageFromBytes warns that a
+// data: URI is likely to push the card past the ~28 KB Teams message limit.
+const DataURIWarnSize = 16 * 1024
+
+// ErrLargeDataURI is returned by ImageFromBytes, together with a usable
+// image, when the encoded image exceeds DataURIWarnSize.
+var ErrLargeDataURI = errors.New("adaptivecard: data URI image is large")
+
+// ----------------------
+// Image
+// ----------------------
+type Image struct {
+	Type string `json:"type"`
+	BaseElement
+	URL                 string  `json:"url"`
+	AltText             string  `json:"altText,omitempty"`
+	Size                string  `json:"size,omitempty"`
+	Style               string  `json:"style,omitempty"`
+	HorizontalAlignment string  `json:"horizontalAlignment,omitempty"`
+	SelectAction        *Action `json:"selectAction,omitempty"`
+}
+
+func NewImage(url string, opts ...ImageOption) Image {
+	i := Image{
+		Type: "Image",
+		URL:  url,
+	}
+	for _, opt := range opts {
+		opt.applyImage(&i)
+	}
+	return i
+}
+func (Image) isElement() {}
+func (i Image) toRaw() any {
+	return i
+}
+
+func (i *Image) WithAltText(altText string) {
+	i.AltText = altText
+}
+
+func (i *Image) WithSize(size string) {
+	i.Size = size
+}
+
+// WithStyle sets "default" or "person" (cropped to a circle).
+func (i *Image) WithStyle(style string) {
+	i.Style = style
+}
+
+func (i *Image) WithHorizontalAlignment(alignment string) {
+	i.HorizontalAlignment = alignment
+}
+
+// WithSelectAction makes the image tappable, e.g. to open the full-size
+// chart.
+func (i *Image) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	i.SelectAction = &a
+}
+
+// ImageFromBytes embeds data as a base64 "data:" URI image, so small generated
+// charts or logos can be sent without hosting them. mime must be an image
+// type such as "image/png". When the encoded URI is larger than
+// DataURIWarnSize the image is still returned, along with an error wrapping
+// ErrLargeDataURI.
+func ImageFromBytes(data []byte, mime string) (Image, error) {
+	if !strings.HasPrefix(mime, "image/") {
+		return Image{}, fmt.Errorf("adaptivecard: %q is not an image MIME type", mime)
+	}
+
+	img := NewImage("data:" + mime + ";base64," + base64.StdEncoding.EncodeToString(data))
+	if n := len(img.URL); n > DataURIWarnSize {
+		return img, fmt.Errorf("%w: %d bytes encoded (warning threshold %d)", ErrLargeDataURI, n, DataURIWarnSize)
+	}
+	return img, nil
+}
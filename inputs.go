@@ -0,0 +1,228 @@
+package adaptivecard
+
+import "time"
+
+// Datasets understood by Teams for dynamically loaded ChoiceSet choices.
+const (
+	// DatasetUsers searches the whole organisation directory.
+	DatasetUsers = "graph.microsoft.com/users"
+	// DatasetChatMembers only offers members of the current chat or channel.
+	DatasetChatMembers = "graph.microsoft.com/users?scope=currentContext"
+)
+
+// InputFields holds the properties shared by every input element.
+type InputFields struct {
+	BaseElement
+	Label        string `json:"label,omitempty"`
+	IsRequired   bool   `json:"isRequired,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+func (in *InputFields) WithLabel(label string) {
+	in.Label = label
+}
+
+// WithRequired makes the input mandatory; errorMessage is shown when the
+// user submits without filling it in.
+func (in *InputFields) WithRequired(errorMessage string) {
+	in.IsRequired = true
+	in.ErrorMessage = errorMessage
+}
+
+// ----------------------
+// Input.ChoiceSet
+// ----------------------
+type ChoiceSetInput struct {
+	Type string `json:"type"`
+	InputFields
+	Placeholder   string     `json:"placeholder,omitempty"`
+	Value         string     `json:"value,omitempty"`
+	Style         string     `json:"style,omitempty"`
+	IsMultiSelect bool       `json:"isMultiSelect,omitempty"`
+	Choices       []Choice   `json:"choices"`
+	ChoicesData   *DataQuery `json:"choices.data,omitempty"`
+}
+
+type Choice struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// DataQuery tells the host to load choices dynamically, either from a
+// well-known dataset such as DatasetUsers or from the bot.
+type DataQuery struct {
+	Type    string `json:"type"`
+	Dataset string `json:"dataset"`
+}
+
+func NewChoiceSetInput(id string, choices ...Choice) ChoiceSetInput {
+	return ChoiceSetInput{
+		Type:        "Input.ChoiceSet",
+		InputFields: InputFields{BaseElement: BaseElement{ID: id}},
+		Choices:     choices,
+	}
+}
+func (ChoiceSetInput) isElement() {}
+func (cs ChoiceSetInput) toRaw() any {
+	if cs.Choices == nil {
+		cs.Choices = []Choice{}
+	}
+	return cs
+}
+
+// NewPeoplePicker returns the Teams people picker: a ChoiceSet whose choices
+// are searched in the organisation directory as the user types. The submitted
+// value is the AAD object ID of the selected user (comma separated when
+// multiSelect is true).
+func NewPeoplePicker(id, label string, multiSelect bool) ChoiceSetInput {
+	cs := NewChoiceSetInput(id)
+	cs.WithLabel(label)
+	cs.WithDataset(DatasetUsers)
+	cs.IsMultiSelect = multiSelect
+	return cs
+}
+
+func (cs *ChoiceSetInput) WithPlaceholder(placeholder string) {
+	cs.Placeholder = placeholder
+}
+
+func (cs *ChoiceSetInput) WithValue(value string) {
+	cs.Value = value
+}
+
+// WithDataset loads the choices dynamically from dataset, e.g. DatasetUsers or
+// DatasetChatMembers.
+func (cs *ChoiceSetInput) WithDataset(dataset string) {
+	cs.ChoicesData = &DataQuery{
+		Type:    "Data.Query",
+		Dataset: dataset,
+	}
+}
+
+func (cs *ChoiceSetInput) AddChoice(title, value string) {
+	cs.Choices = append(cs.Choices, Choice{Title: title, Value: value})
+}
+
+// ----------------------
+// Input.Date
+// ----------------------
+
+// DateInput is a date picker. Dates are "YYYY-MM-DD" strings.
+type DateInput struct {
+	Type string `json:"type"`
+	InputFields
+	Placeholder string `json:"placeholder,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Min         string `json:"min,omitempty"`
+	Max         string `json:"max,omitempty"`
+}
+
+func NewDateInput(id string) DateInput {
+	return DateInput{
+		Type:        "Input.Date",
+		InputFields: InputFields{BaseElement: BaseElement{ID: id}},
+	}
+}
+func (DateInput) isElement() {}
+func (d DateInput) toRaw() any {
+	return d
+}
+
+func (d *DateInput) WithPlaceholder(placeholder string) {
+	d.Placeholder = placeholder
+}
+
+func (d *DateInput) WithValue(value time.Time) {
+	d.Value = value.Format(time.DateOnly)
+}
+
+// WithRange limits the selectable dates; a zero time leaves that end open.
+func (d *DateInput) WithRange(min, max time.Time) {
+	if !min.IsZero() {
+		d.Min = min.Format(time.DateOnly)
+	}
+	if !max.IsZero() {
+		d.Max = max.Format(time.DateOnly)
+	}
+}
+
+// ----------------------
+// Input.Time
+// ----------------------
+
+// TimeInput is a time picker. Times are "HH:MM" strings.
+type TimeInput struct {
+	Type string `json:"type"`
+	InputFields
+	Placeholder string `json:"placeholder,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Min         string `json:"min,omitempty"`
+	Max         string `json:"max,omitempty"`
+}
+
+func NewTimeInput(id string) TimeInput {
+	return TimeInput{
+		Type:        "Input.Time",
+		InputFields: InputFields{BaseElement: BaseElement{ID: id}},
+	}
+}
+func (TimeInput) isElement() {}
+func (t TimeInput) toRaw() any {
+	return t
+}
+
+const timeOfDay = "15:04"
+
+func (t *TimeInput) WithPlaceholder(placeholder string) {
+	t.Placeholder = placeholder
+}
+
+// WithValue sets the initial time; only the hour and minute of value are
+// used.
+func (t *TimeInput) WithValue(value time.Time) {
+	t.Value = value.Format(timeOfDay)
+}
+
+// WithRange limits the selectable times to between min and max, given as
+// "HH:MM".
+func (t *TimeInput) WithRange(min, max string) {
+	t.Min = min
+	t.Max = max
+}
+
+// ----------------------
+// Input.Number
+// ----------------------
+type NumberInput struct {
+	Type string `json:"type"`
+	InputFields
+	Placeholder string   `json:"placeholder,omitempty"`
+	Value       *float64 `json:"value,omitempty"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+}
+
+func NewNumberInput(id string) NumberInput {
+	return NumberInput{
+		Type:        "Input.Number",
+		InputFields: InputFields{BaseElement: BaseElement{ID: id}},
+	}
+}
+func (NumberInput) isElement() {}
+func (n NumberInput) toRaw() any {
+	return n
+}
+
+func (n *NumberInput) WithPlaceholder(placeholder string) {
+	n.Placeholder = placeholder
+}
+
+func (n *NumberInput) WithValue(value float64) {
+	n.Value = &value
+}
+
+// WithRange limits the accepted values to [min, max].
+func (n *NumberInput) WithRange(min, max float64) {
+	n.Min = &min
+	n.Max = &max
+}
@@ -0,0 +1,73 @@
+// Command genicons generates the typed Fluent icon catalog (icon_names.go)
+// from a plain-text list of icon names.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+func main() {
+	in := flag.String("in", "icons.txt", "icon name list, one per line")
+	out := flag.String("out", "icon_names.go", "generated Go file")
+	pkg := flag.String("pkg", "adaptivecard", "package name of the generated file")
+	flag.Parse()
+
+	names, err := readNames(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genicons from %s; DO NOT EDIT.\n\n", *in)
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+	buf.WriteString("const (\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\tIcon%s IconName = %q\n", name, name)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("var iconNames = map[IconName]struct{}{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\tIcon%s: {},\n", name)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readNames returns the sorted, de-duplicated icon names listed in path.
+func readNames(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var names []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
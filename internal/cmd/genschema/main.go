@@ -0,0 +1,295 @@
+// Command genschema generates schema metadata (schema_gen.go) from a typed
+// schema in the format of the official Adaptive Cards repository
+// (github.com/microsoft/AdaptiveCards, schemas/src).
+//
+// The typed schema describes one class per JSON file, named after the class
+// (e.g. "Input.Text.json"). Classes with "classType": "Enum" list their
+// "values"; all other classes list their "properties", each optionally tagged
+// with the "version" that introduced it, and name the class they "extend".
+// genschema turns that into the typeVersions and propertyVersions tables and
+// into a Go string type with constants and a Valid method for each enum named
+// by -enums.
+//
+// The schema directory in this folder holds the classes the package
+// supports, plus the Teams-only Icon, Badge and ProgressBar elements. To pick
+// up a schema release, update those files (or point -schema at a checkout)
+// and run go generate. The element and action structs stay hand-written,
+// because their marshaling (nested elements, fallbacks) is not expressible
+// in the schema.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// roots are the base classes whose properties every element or action
+// inherits. Their properties are keyed by the root name, e.g.
+// "Element.isVisible", rather than repeated for every type.
+var roots = []string{"Element", "Action"}
+
+// class is one file of the typed schema.
+type class struct {
+	ClassType   string                     `json:"classType"`
+	Description string                     `json:"description"`
+	IsAbstract  bool                       `json:"isAbstract"`
+	Extends     string                     `json:"extends"`
+	Version     string                     `json:"version"`
+	Values      []json.RawMessage          `json:"values"`
+	Properties  map[string]json.RawMessage `json:"properties"`
+}
+
+type property struct {
+	Version string `json:"version"`
+}
+
+// enum maps a schema enum to the Go type generated for it.
+type enum struct {
+	schema, goName string
+}
+
+func main() {
+	log.SetFlags(0)
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatal("genschema: ", err)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("genschema", flag.ContinueOnError)
+	schema := flags.String("schema", "schema", "directory holding the typed schema")
+	out := flags.String("out", "schema_gen.go", "generated Go file")
+	pkg := flags.String("pkg", "adaptivecard", "package name of the generated file")
+	enumList := flags.String("enums", "", "comma-separated SchemaEnum=GoType pairs to generate")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	enums, err := parseEnums(*enumList)
+	if err != nil {
+		return err
+	}
+	classes, err := readClasses(*schema)
+	if err != nil {
+		return err
+	}
+	src, err := render(*pkg, *schema, classes, enums)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+func parseEnums(list string) ([]enum, error) {
+	var enums []enum
+	for _, pair := range strings.Split(list, ",") {
+		if pair == "" {
+			continue
+		}
+		schema, goName, ok := strings.Cut(pair, "=")
+		if !ok || schema == "" || goName == "" {
+			return nil, fmt.Errorf("-enums: %q is not SchemaEnum=GoType", pair)
+		}
+		enums = append(enums, enum{schema, goName})
+	}
+	return enums, nil
+}
+
+// readClasses loads every *.json file below dir, keyed by class name (the
+// file name without extension, e.g. "Input.Text").
+func readClasses(dir string) (map[string]class, error) {
+	classes := make(map[string]class)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var c class
+		if err := json.Unmarshal(data, &c); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		classes[strings.TrimSuffix(d.Name(), ".json")] = c
+		return nil
+	})
+	return classes, err
+}
+
+func render(pkg, dir string, classes map[string]class, enums []enum) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genschema from %s; DO NOT EDIT.\n\n", filepath.ToSlash(dir))
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	for _, e := range enums {
+		c, ok := classes[e.schema]
+		if !ok || c.ClassType != "Enum" {
+			return nil, fmt.Errorf("no enum %s in %s", e.schema, dir)
+		}
+		renderEnum(&buf, e, c)
+	}
+
+	types, props, err := versions(classes)
+	if err != nil {
+		return nil, err
+	}
+	writeComment(&buf, "typeVersions is the schema version that introduced each element and action type.")
+	writeMap(&buf, "typeVersions", types)
+	writeComment(&buf, `propertyVersions lists properties introduced after their type, keyed by "Type.property". "Element.property" and "Action.property" entries apply to the properties every element or action inherits.`)
+	writeMap(&buf, "propertyVersions", props)
+
+	return format.Source(buf.Bytes())
+}
+
+func renderEnum(buf *bytes.Buffer, e enum, c class) {
+	doc := fmt.Sprintf("%s is the %s enum of the schema.", e.goName, e.schema)
+	if c.Description != "" {
+		doc += " " + c.Description
+	}
+	writeComment(buf, doc)
+	fmt.Fprintf(buf, "type %s string\n\nconst (\n", e.goName)
+	names := make([]string, len(c.Values))
+	for i, raw := range c.Values {
+		v := enumValue(raw)
+		names[i] = e.goName + strings.ToUpper(v[:1]) + v[1:]
+		fmt.Fprintf(buf, "%s %s = %q\n", names[i], e.goName, v)
+	}
+	buf.WriteString(")\n\n")
+	recv := strings.ToLower(e.goName[:1])
+	writeComment(buf, fmt.Sprintf("Valid reports whether %s is empty or a known %s. Hosts compare enum values case-insensitively.", recv, e.goName))
+	fmt.Fprintf(buf, "func (%s %s) Valid() bool {\n\treturn validEnum(%s, %s)\n}\n\n", recv, e.goName, recv, strings.Join(names, ", "))
+}
+
+// versions derives the type and property version tables. Types are the
+// concrete classes that extend a root; a property is listed when it is
+// younger than the type (or root) it is keyed by.
+func versions(classes map[string]class) (types, props map[string]string, err error) {
+	types = make(map[string]string)
+	props = make(map[string]string)
+	for name, c := range classes {
+		if c.ClassType == "Enum" {
+			continue
+		}
+		chain, err := ancestry(classes, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		root := slices.IndexFunc(chain, func(n string) bool { return slices.Contains(roots, n) })
+		switch {
+		case slices.Contains(roots, name):
+			// A root owns its own properties and those of its ancestors.
+		case c.IsAbstract:
+			continue
+		default:
+			if root >= 0 {
+				types[name] = versionOr(c.Version)
+				chain = chain[:root]
+			}
+		}
+		since := versionOr(c.Version)
+		for _, n := range chain {
+			for p, raw := range classes[n].Properties {
+				var prop property
+				if err := json.Unmarshal(raw, &prop); err != nil {
+					return nil, nil, fmt.Errorf("%s.%s: %w", n, p, err)
+				}
+				if v := versionOr(prop.Version, since); compareVersions(v, since) > 0 {
+					props[name+"."+p] = v
+				}
+			}
+		}
+	}
+	return types, props, nil
+}
+
+// ancestry returns name followed by the classes it extends, nearest first.
+func ancestry(classes map[string]class, name string) ([]string, error) {
+	var chain []string
+	for n := name; n != ""; n = classes[n].Extends {
+		if slices.Contains(chain, n) {
+			return nil, fmt.Errorf("%s extends itself", n)
+		}
+		if _, ok := classes[n]; !ok {
+			return nil, fmt.Errorf("%s extends unknown class %s", chain[len(chain)-1], n)
+		}
+		chain = append(chain, n)
+	}
+	return chain, nil
+}
+
+// writeComment writes text as a line comment wrapped at 80 columns.
+func writeComment(buf *bytes.Buffer, text string) {
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 && line != "//" {
+			buf.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	buf.WriteString(line + "\n")
+}
+
+func writeMap(buf *bytes.Buffer, name string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	fmt.Fprintf(buf, "var %s = map[string]string{\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%q: %q,\n", k, m[k])
+	}
+	buf.WriteString("}\n\n")
+}
+
+// enumValue accepts both plain string values and {"value": "..."} objects.
+func enumValue(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var v struct {
+		Value string `json:"value"`
+	}
+	_ = json.Unmarshal(raw, &v)
+	return v.Value
+}
+
+// versionOr returns the first non-empty version, defaulting to "1.0".
+func versionOr(versions ...string) string {
+	for _, v := range versions {
+		if v != "" {
+			return v
+		}
+	}
+	return "1.0"
+}
+
+// compareVersions compares dotted versions numerically.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range max(len(as), len(bs)) {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if c := x - y; c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedFileIsCurrent runs the go:generate directive in features.go
+// and checks that schema_gen.go matches its output.
+func TestGeneratedFileIsCurrent(t *testing.T) {
+	t.Chdir(filepath.Join("..", "..", ".."))
+	src, err := os.ReadFile("features.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const directive = "//go:generate go run ./internal/cmd/genschema "
+	var args []string
+	for _, line := range strings.Split(string(src), "\n") {
+		if rest, ok := strings.CutPrefix(line, directive); ok {
+			args = strings.Fields(rest)
+		}
+	}
+	if args == nil {
+		t.Fatal("no genschema directive in features.go")
+	}
+	out := filepath.Join(t.TempDir(), "schema_gen.go")
+	if err := run(append(args, "-out", out)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("schema_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("schema_gen.go is stale; run go generate")
+	}
+}
+
+func TestVersions(t *testing.T) {
+	props := func(kv ...string) map[string]json.RawMessage {
+		m := map[string]json.RawMessage{}
+		for i := 0; i < len(kv); i += 2 {
+			m[kv[i]] = json.RawMessage(`{"type":"string","version":"` + kv[i+1] + `"}`)
+		}
+		return m
+	}
+	classes := map[string]class{
+		"Item":      {IsAbstract: true, Properties: props("requires", "1.2")},
+		"Element":   {IsAbstract: true, Extends: "Item", Version: "1.0", Properties: props("id", "1.0", "isVisible", "1.2")},
+		"Input":     {IsAbstract: true, Extends: "Element", Properties: props("label", "1.3")},
+		"Input.Foo": {Extends: "Input", Version: "1.0", Properties: props("value", "1.0", "wrap", "1.2")},
+		"Bar":       {Extends: "Element", Version: "1.5", Properties: props("old", "1.1", "new", "1.6")},
+		"Card":      {Version: "1.0", Properties: props("refresh", "1.4")},
+		"Size":      {ClassType: "Enum"},
+	}
+	types, got, err := versions(classes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"Input.Foo": "1.0", "Bar": "1.5"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("types = %v, want %v", types, want)
+	}
+	want := map[string]string{
+		"Element.isVisible": "1.2",
+		"Element.requires":  "1.2",
+		"Input.Foo.label":   "1.3",
+		"Input.Foo.wrap":    "1.2",
+		"Bar.new":           "1.6",
+		"Card.refresh":      "1.4",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("properties = %v, want %v", got, want)
+	}
+
+	classes["Item"] = class{Extends: "Element"}
+	if _, _, err := versions(classes); err == nil {
+		t.Error("cyclic extends accepted")
+	}
+}
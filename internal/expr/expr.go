@@ -0,0 +1,589 @@
+// Package expr evaluates the subset of Adaptive Expressions used by the
+// Adaptive Card Template Language: literals, property paths and indexing,
+// the arithmetic, comparison and logical operators, and the common built-in
+// functions (see functions.go).
+//
+// Values are those of encoding/json decoding into any: nil, bool, float64,
+// string, []any and map[string]any. Reading a missing property yields nil
+// rather than an error, as in Adaptive Expressions.
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Env holds what an expression can refer to.
+type Env struct {
+	// Data is the current data context; bare identifiers are its properties.
+	Data any
+	// Vars are the $-prefixed names, e.g. $root, $data and $index.
+	Vars map[string]any
+}
+
+// Eval parses and evaluates src.
+func Eval(src string, env Env) (any, error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return n.Eval(env)
+}
+
+// Node is a parsed expression.
+type Node interface {
+	Eval(env Env) (any, error)
+}
+
+// Parse parses src into a Node that can be evaluated repeatedly.
+func Parse(src string) (Node, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokEOF {
+		return nil, p.errorf("unexpected %q", p.peek().text)
+	}
+	return n, nil
+}
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+}
+
+// operators, longest first so "<=" wins over "<".
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "<>", "(", ")", "[", "]", ",", ".", "!", "+", "-", "*", "/", "%", "<", ">", "&"}
+
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			toks = append(toks, token{tokNumber, src[start:i], start})
+		case c == '\'' || c == '"':
+			start := i
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, fmt.Errorf("unterminated string at %d", start)
+				}
+				if src[i] == '\\' && i+1 < len(src) {
+					i++
+					sb.WriteByte(unescape(src[i]))
+					continue
+				}
+				if src[i] == c {
+					i++
+					break
+				}
+				sb.WriteByte(src[i])
+			}
+			toks = append(toks, token{tokString, sb.String(), start})
+		case isIdentByte(c) && !(c >= '0' && c <= '9'):
+			start := i
+			for i < len(src) && isIdentByte(src[i]) {
+				i++
+			}
+			toks = append(toks, token{tokIdent, src[start:i], start})
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			toks = append(toks, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(toks, token{tokEOF, "", len(src)}), nil
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c == '@' ||
+		c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func unescape(c byte) byte {
+	switch c {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	}
+	return c
+}
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is one of the operators ops.
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		return p.errorf("expected %q", op)
+	}
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at %d", fmt.Sprintf(format, args...), p.peek().pos)
+}
+
+// binary levels, loosest first.
+var levels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<>"},
+	{"<", "<=", ">", ">="},
+	{"+", "-", "&"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) expr() (Node, error) {
+	return p.binary(0)
+}
+
+func (p *parser) binary(level int) (Node, error) {
+	if level == len(levels) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(levels[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op, left, right}
+	}
+}
+
+func (p *parser) unary() (Node, error) {
+	if op, ok := p.accept("!", "-", "+"); ok {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op, operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (Node, error) {
+	n, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch op, _ := p.accept(".", "["); op {
+		case ".":
+			t := p.next()
+			if t.kind != tokIdent {
+				return nil, p.errorf("expected property name")
+			}
+			n = indexNode{n, literal{t.text}}
+		case "[":
+			index, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = indexNode{n, index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) primary() (Node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number %q at %d", t.text, t.pos)
+		}
+		return literal{f}, nil
+	case tokString:
+		return literal{t.text}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return literal{true}, nil
+		case "false":
+			return literal{false}, nil
+		case "null":
+			return literal{nil}, nil
+		}
+		if _, ok := p.accept("("); ok {
+			return p.call(t.text)
+		}
+		return ident(t.text), nil
+	case tokOp:
+		if t.text == "(" {
+			n, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		}
+	}
+	if t.kind == tokEOF {
+		return nil, p.errorf("unexpected end of expression")
+	}
+	p.pos--
+	return nil, p.errorf("unexpected %q", t.text)
+}
+
+func (p *parser) call(name string) (Node, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+	var args []Node
+	if _, ok := p.accept(")"); ok {
+		return callNode{name, fn, args}, nil
+	}
+	for {
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if _, ok := p.accept(","); !ok {
+			break
+		}
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return callNode{name, fn, args}, nil
+}
+
+type literal struct{ v any }
+
+func (n literal) Eval(Env) (any, error) { return n.v, nil }
+
+type ident string
+
+func (n ident) Eval(env Env) (any, error) {
+	if strings.HasPrefix(string(n), "$") {
+		return env.Vars[string(n)], nil
+	}
+	return property(env.Data, string(n)), nil
+}
+
+type indexNode struct {
+	target, index Node
+}
+
+func (n indexNode) Eval(env Env) (any, error) {
+	target, err := n.target.Eval(env)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.Eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch index := index.(type) {
+	case string:
+		return property(target, index), nil
+	case float64:
+		if arr, ok := target.([]any); ok {
+			i := int(index)
+			if i < 0 || i >= len(arr) || float64(i) != index {
+				return nil, nil
+			}
+			return arr[i], nil
+		}
+		return nil, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot index with %s", typeName(index))
+}
+
+func property(v any, name string) any {
+	if m, ok := v.(map[string]any); ok {
+		return m[name]
+	}
+	return nil
+}
+
+type unaryNode struct {
+	op      string
+	operand Node
+}
+
+func (n unaryNode) Eval(env Env) (any, error) {
+	v, err := n.operand.Eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		return !Truthy(v), nil
+	}
+	f, err := number(n.op, v)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "-" {
+		f = -f
+	}
+	return f, nil
+}
+
+type binaryNode struct {
+	op          string
+	left, right Node
+}
+
+func (n binaryNode) Eval(env Env) (any, error) {
+	l, err := n.left.Eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "&&":
+		if !Truthy(l) {
+			return false, nil
+		}
+	case "||":
+		if Truthy(l) {
+			return true, nil
+		}
+	}
+	r, err := n.right.Eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "&&", "||":
+		return Truthy(r), nil
+	case "==":
+		return equal(l, r), nil
+	case "!=", "<>":
+		return !equal(l, r), nil
+	case "&":
+		return String(l) + String(r), nil
+	case "+":
+		_, ls := l.(string)
+		_, rs := r.(string)
+		if ls || rs {
+			return String(l) + String(r), nil
+		}
+	case "<", "<=", ">", ">=":
+		return compare(n.op, l, r)
+	}
+	return arithmetic(n.op, l, r)
+}
+
+type callNode struct {
+	name string
+	fn   function
+	args []Node
+}
+
+func (n callNode) Eval(env Env) (any, error) {
+	args := make([]any, len(n.args))
+	for i, a := range n.args {
+		v, err := a.Eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	if len(args) < n.fn.min || n.fn.max >= 0 && len(args) > n.fn.max {
+		return nil, fmt.Errorf("wrong number of arguments to %s: %d", n.name, len(args))
+	}
+	v, err := n.fn.call(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return v, nil
+}
+
+// Truthy reports whether v counts as true: anything but false and null.
+func Truthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+	return true
+}
+
+// String formats v for interpolation into text: numbers without trailing
+// zeros, null as the empty string and objects and arrays as JSON.
+func String(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return jsonString(v)
+}
+
+func number(op string, v any) (float64, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("%s needs a number, got %s", op, typeName(v))
+	}
+	return f, nil
+}
+
+func arithmetic(op string, l, r any) (any, error) {
+	a, err := number(op, l)
+	if err != nil {
+		return nil, err
+	}
+	b, err := number(op, r)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	}
+	if b == 0 {
+		return nil, fmt.Errorf("division by zero")
+	}
+	if op == "/" {
+		return a / b, nil
+	}
+	return math.Mod(a, b), nil
+}
+
+func compare(op string, l, r any) (any, error) {
+	var c int
+	switch l := l.(type) {
+	case float64:
+		b, err := number(op, r)
+		if err != nil {
+			return nil, err
+		}
+		c = cmpFloat(l, b)
+	case string:
+		b, ok := r.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare string with %s", typeName(r))
+		}
+		c = strings.Compare(l, b)
+	default:
+		return nil, fmt.Errorf("cannot compare %s", typeName(l))
+	}
+	switch op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,156 @@
+package expr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testEnv = Env{
+	Data: map[string]any{
+		"name":  "Ana",
+		"count": 3.0,
+		"price": 2.5,
+		"tags":  []any{"go", "cards"},
+		"user":  map[string]any{"email": "ana@example.com", "admin": true},
+		"empty": "",
+	},
+	Vars: map[string]any{
+		"$index": 1.0,
+		"$root":  map[string]any{"title": "Report"},
+	},
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		src  string
+		want any
+	}{
+		// literals
+		{`42`, 42.0},
+		{`1.5`, 1.5},
+		{`'single'`, "single"},
+		{`"double"`, "double"},
+		{`'it\'s'`, "it's"},
+		{`true`, true},
+		{`null`, nil},
+
+		// paths and indexing
+		{`name`, "Ana"},
+		{`user.email`, "ana@example.com"},
+		{`user['admin']`, true},
+		{`tags[1]`, "cards"},
+		{`tags[$index]`, "cards"},
+		{`$root.title`, "Report"},
+		{`missing`, nil},
+		{`missing.deeper`, nil},
+
+		// operators
+		{`count + 2 * 3`, 9.0},
+		{`(count + 2) * 3`, 15.0},
+		{`count % 2`, 1.0},
+		{`5 % 0.5`, 0.0},
+		{`5.5 % 2`, 1.5},
+		{`-7 % 2`, -1.0},
+		{`mod(5, 0.75)`, 0.5},
+		{`-count`, -3.0},
+		{`'Hi ' + name`, "Hi Ana"},
+		{`name & count`, "Ana3"},
+		{`count > 2 && price < 3`, true},
+		{`count == 3 || missing`, true},
+		{`!user.admin`, false},
+		{`name != 'Ben'`, true},
+		{`name <> 'Ana'`, false},
+
+		// functions
+		{`toUpper(name)`, "ANA"},
+		{`concat(name, '-', count)`, "Ana-3"},
+		{`if(user.admin, 'admin', 'user')`, "admin"},
+		{`coalesce(missing, empty, 'x')`, ""},
+		{`count(tags)`, 2.0},
+		{`join(tags, ', ')`, "go, cards"},
+		{`contains(tags, 'go')`, true},
+		{`formatNumber(price, 2)`, "2.50"},
+		{`max(1, count, 2)`, 3.0},
+		{`substring(name, 1)`, "na"},
+		{`exists(missing)`, false},
+		{`empty(empty)`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			got, err := Eval(tt.src, testEnv)
+			if err != nil {
+				t.Fatalf("Eval(%q): %v", tt.src, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Eval(%q) = %#v, want %#v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{`'open`, "unterminated string"},
+		{`1 +`, ""},
+		{`(1`, ""},
+		{`1 2`, "unexpected"},
+		{`nosuchfn(1)`, ""},
+		{`toUpper()`, "wrong number of arguments"},
+		{`name * 2`, ""},
+		{`count % 0`, "division by zero"},
+		{`mod(5, 0)`, "division by zero"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			_, err := Eval(tt.src, testEnv)
+			if err == nil {
+				t.Fatalf("Eval(%q) succeeded", tt.src)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Eval(%q) error = %v, want %q", tt.src, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruthy(t *testing.T) {
+	tests := []struct {
+		v    any
+		want bool
+	}{
+		{nil, false},
+		{false, false},
+		{true, true},
+		{0.0, true},
+		{"", true},
+		{[]any{}, true},
+	}
+	for _, tt := range tests {
+		if got := Truthy(tt.v); got != tt.want {
+			t.Errorf("Truthy(%#v) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{nil, ""},
+		{"text", "text"},
+		{true, "true"},
+		{3.0, "3"},
+		{0.25, "0.25"},
+		{[]any{1.0, "a"}, `[1,"a"]`},
+		{map[string]any{"a": 1.0}, `{"a":1}`},
+	}
+	for _, tt := range tests {
+		if got := String(tt.v); got != tt.want {
+			t.Errorf("String(%#v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
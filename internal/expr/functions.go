@@ -0,0 +1,307 @@
+package expr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type function struct {
+	// min and max bound the argument count; max -1 is variadic.
+	min, max int
+	call     func(args []any) (any, error)
+}
+
+var functions = map[string]function{
+	// Strings.
+	"concat": {1, -1, func(args []any) (any, error) {
+		var sb strings.Builder
+		for _, a := range args {
+			sb.WriteString(String(a))
+		}
+		return sb.String(), nil
+	}},
+	"toUpper":    stringFn(strings.ToUpper),
+	"toLower":    stringFn(strings.ToLower),
+	"trim":       stringFn(strings.TrimSpace),
+	"startsWith": stringPred(strings.HasPrefix),
+	"endsWith":   stringPred(strings.HasSuffix),
+	"replace": {3, 3, func(args []any) (any, error) {
+		return strings.ReplaceAll(String(args[0]), String(args[1]), String(args[2])), nil
+	}},
+	"split": {2, 2, func(args []any) (any, error) {
+		parts := strings.Split(String(args[0]), String(args[1]))
+		out := make([]any, len(parts))
+		for i, p := range parts {
+			out[i] = p
+		}
+		return out, nil
+	}},
+	"substring": {2, 3, func(args []any) (any, error) {
+		s := []rune(String(args[0]))
+		start, err := intArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		end := len(s)
+		if len(args) == 3 {
+			n, err := intArg(args[2])
+			if err != nil {
+				return nil, err
+			}
+			end = start + n
+		}
+		if start < 0 || end > len(s) || start > end {
+			return nil, fmt.Errorf("range [%d:%d] out of bounds for length %d", start, end, len(s))
+		}
+		return string(s[start:end]), nil
+	}},
+	"indexOf": {2, 2, func(args []any) (any, error) {
+		if arr, ok := args[0].([]any); ok {
+			for i, v := range arr {
+				if equal(v, args[1]) {
+					return float64(i), nil
+				}
+			}
+			return float64(-1), nil
+		}
+		s, sub := String(args[0]), String(args[1])
+		i := strings.Index(s, sub)
+		if i < 0 {
+			return float64(-1), nil
+		}
+		return float64(len([]rune(s[:i]))), nil
+	}},
+	"string": {1, 1, func(args []any) (any, error) { return String(args[0]), nil }},
+	"formatNumber": {2, 2, func(args []any) (any, error) {
+		f, err := number("formatNumber", args[0])
+		if err != nil {
+			return nil, err
+		}
+		prec, err := intArg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		return strconv.FormatFloat(f, 'f', prec, 64), nil
+	}},
+
+	// Logic and comparison.
+	"if": {3, 3, func(args []any) (any, error) {
+		if Truthy(args[0]) {
+			return args[1], nil
+		}
+		return args[2], nil
+	}},
+	"not": {1, 1, func(args []any) (any, error) { return !Truthy(args[0]), nil }},
+	"and": {1, -1, func(args []any) (any, error) {
+		for _, a := range args {
+			if !Truthy(a) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}},
+	"or": {1, -1, func(args []any) (any, error) {
+		for _, a := range args {
+			if Truthy(a) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}},
+	"equals": {2, 2, func(args []any) (any, error) { return equal(args[0], args[1]), nil }},
+	"exists": {1, 1, func(args []any) (any, error) { return args[0] != nil, nil }},
+	"empty":  {1, 1, func(args []any) (any, error) { return empty(args[0]), nil }},
+	"coalesce": {1, -1, func(args []any) (any, error) {
+		for _, a := range args {
+			if a != nil {
+				return a, nil
+			}
+		}
+		return nil, nil
+	}},
+	"bool": {1, 1, func(args []any) (any, error) { return Truthy(args[0]), nil }},
+
+	// Math.
+	"add": arithFn("+"),
+	"sub": arithFn("-"),
+	"mul": arithFn("*"),
+	"div": arithFn("/"),
+	"mod": arithFn("%"),
+	"max": {1, -1, func(args []any) (any, error) { return fold(args, math.Max) }},
+	"min": {1, -1, func(args []any) (any, error) { return fold(args, math.Min) }},
+	"int": {1, 1, func(args []any) (any, error) {
+		f, err := toNumber(args[0])
+		return math.Trunc(f), err
+	}},
+	"float": {1, 1, func(args []any) (any, error) { return toNumber(args[0]) }},
+	"round": {1, 2, func(args []any) (any, error) {
+		f, err := number("round", args[0])
+		if err != nil {
+			return nil, err
+		}
+		digits := 0
+		if len(args) == 2 {
+			if digits, err = intArg(args[1]); err != nil {
+				return nil, err
+			}
+		}
+		scale := math.Pow(10, float64(digits))
+		return math.Round(f*scale) / scale, nil
+	}},
+
+	// Collections.
+	"count":  {1, 1, length},
+	"length": {1, 1, length},
+	"first": {1, 1, func(args []any) (any, error) {
+		if arr, ok := args[0].([]any); ok && len(arr) > 0 {
+			return arr[0], nil
+		}
+		return nil, nil
+	}},
+	"last": {1, 1, func(args []any) (any, error) {
+		if arr, ok := args[0].([]any); ok && len(arr) > 0 {
+			return arr[len(arr)-1], nil
+		}
+		return nil, nil
+	}},
+	"join": {2, 2, func(args []any) (any, error) {
+		arr, ok := args[0].([]any)
+		if !ok {
+			return nil, fmt.Errorf("needs an array, got %s", typeName(args[0]))
+		}
+		parts := make([]string, len(arr))
+		for i, v := range arr {
+			parts[i] = String(v)
+		}
+		return strings.Join(parts, String(args[1])), nil
+	}},
+	"contains": {2, 2, func(args []any) (any, error) {
+		switch c := args[0].(type) {
+		case string:
+			return strings.Contains(c, String(args[1])), nil
+		case []any:
+			for _, v := range c {
+				if equal(v, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[string]any:
+			_, ok := c[String(args[1])]
+			return ok, nil
+		}
+		return false, nil
+	}},
+	"createArray": {0, -1, func(args []any) (any, error) { return append([]any{}, args...), nil }},
+	"json": {1, 1, func(args []any) (any, error) {
+		var v any
+		if err := json.Unmarshal([]byte(String(args[0])), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}},
+}
+
+func stringFn(f func(string) string) function {
+	return function{1, 1, func(args []any) (any, error) { return f(String(args[0])), nil }}
+}
+
+func stringPred(f func(s, affix string) bool) function {
+	return function{2, 2, func(args []any) (any, error) { return f(String(args[0]), String(args[1])), nil }}
+}
+
+func arithFn(op string) function {
+	return function{2, 2, func(args []any) (any, error) { return arithmetic(op, args[0], args[1]) }}
+}
+
+func fold(args []any, f func(a, b float64) float64) (any, error) {
+	if len(args) == 1 {
+		if arr, ok := args[0].([]any); ok {
+			if len(arr) == 0 {
+				return nil, errors.New("empty array")
+			}
+			args = arr
+		}
+	}
+	acc, err := number("argument", args[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range args[1:] {
+		b, err := number("argument", a)
+		if err != nil {
+			return nil, err
+		}
+		acc = f(acc, b)
+	}
+	return acc, nil
+}
+
+func length(args []any) (any, error) {
+	switch v := args[0].(type) {
+	case string:
+		return float64(len([]rune(v))), nil
+	case []any:
+		return float64(len(v)), nil
+	case map[string]any:
+		return float64(len(v)), nil
+	case nil:
+		return float64(0), nil
+	}
+	return nil, fmt.Errorf("needs a string or collection, got %s", typeName(args[0]))
+}
+
+func intArg(v any) (int, error) {
+	f, err := number("argument", v)
+	return int(f), err
+}
+
+func toNumber(v any) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", v)
+		}
+		return f, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("cannot convert %s to a number", typeName(v))
+}
+
+func empty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func equal(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
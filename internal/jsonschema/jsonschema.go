@@ -0,0 +1,413 @@
+// Package jsonschema validates JSON documents against the subset of JSON
+// Schema (draft-06) used by the Adaptive Cards schema: type, enum, const,
+// required, properties, additionalProperties, items, minItems, maxItems,
+// minimum, maximum, minLength, pattern, allOf, anyOf, oneOf, not and $ref to
+// JSON pointers within the schema. Unknown keywords are ignored.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// Schema is a compiled schema document.
+type Schema struct {
+	root     any
+	patterns map[string]*regexp.Regexp
+}
+
+// Error is one violation, at the JSON path ("$.body[0].text") of the
+// offending value.
+type Error struct {
+	Path    string
+	Message string
+
+	// wrongType is set when the value has the wrong JSON type altogether.
+	wrongType bool
+}
+
+func (e Error) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Compile parses a schema document.
+func Compile(data []byte) (*Schema, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var root any
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("jsonschema: %w", err)
+	}
+	if _, ok := root.(map[string]any); !ok {
+		return nil, fmt.Errorf("jsonschema: schema is not an object")
+	}
+	return &Schema{root: root, patterns: map[string]*regexp.Regexp{}}, nil
+}
+
+// Validate checks instance, a tree produced by orderedjson.Parse, against
+// the schema. Violations are reported in document order.
+func (s *Schema) Validate(instance any) []Error {
+	return s.validate(s.root, instance, "$", 0)
+}
+
+// maxRefDepth stops reference cycles that never consume any input.
+const maxRefDepth = 64
+
+func (s *Schema) validate(node, v any, path string, refs int) []Error {
+	switch node := node.(type) {
+	case bool:
+		if !node {
+			return []Error{{Path: path, Message: "no value is allowed here"}}
+		}
+		return nil
+	case map[string]any:
+		return s.validateObject(node, v, path, refs)
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(node map[string]any, v any, path string, refs int) []Error {
+	if ref, ok := node["$ref"].(string); ok {
+		target, err := s.resolve(ref)
+		if err != nil {
+			return []Error{{Path: path, Message: err.Error()}}
+		}
+		if refs >= maxRefDepth {
+			return []Error{{Path: path, Message: "schema references nest too deeply"}}
+		}
+		return s.validate(target, v, path, refs+1)
+	}
+
+	var errs []Error
+	fail := func(format string, args ...any) {
+		errs = append(errs, Error{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if t, ok := node["type"]; ok && !matchesType(t, v) {
+		return []Error{{Path: path, Message: fmt.Sprintf("expected %s, got %s", typeNames(t), typeOf(v)), wrongType: true}}
+	}
+	if enum, ok := node["enum"].([]any); ok && !containsValue(enum, v) {
+		fail("value %s is not one of %s", show(v), showList(enum))
+	}
+	if c, ok := node["const"]; ok && !equal(c, v) {
+		fail("value %s must be %s", show(v), show(c))
+	}
+
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		errs = append(errs, s.validateMembers(node, v, path, refs)...)
+	case []any:
+		if n, ok := number(node["minItems"]); ok && float64(len(v)) < n {
+			fail("array has %d items, at least %g required", len(v), n)
+		}
+		if n, ok := number(node["maxItems"]); ok && float64(len(v)) > n {
+			fail("array has %d items, at most %g allowed", len(v), n)
+		}
+		if items, ok := node["items"]; ok {
+			for i, item := range v {
+				errs = append(errs, s.validate(items, item, fmt.Sprintf("%s[%d]", path, i), refs)...)
+			}
+		}
+	case string:
+		if n, ok := number(node["minLength"]); ok && float64(len([]rune(v))) < n {
+			fail("string is shorter than %g characters", n)
+		}
+		if p, ok := node["pattern"].(string); ok {
+			re, err := s.pattern(p)
+			if err != nil {
+				fail("invalid pattern %q in schema", p)
+			} else if !re.MatchString(v) {
+				fail("value %q does not match pattern %q", v, p)
+			}
+		}
+	case json.Number:
+		f, _ := v.Float64()
+		if n, ok := number(node["minimum"]); ok && f < n {
+			fail("value %s is less than the minimum %g", v, n)
+		}
+		if n, ok := number(node["maximum"]); ok && f > n {
+			fail("value %s is greater than the maximum %g", v, n)
+		}
+	}
+
+	if all, ok := node["allOf"].([]any); ok {
+		for _, sub := range all {
+			errs = append(errs, s.validate(sub, v, path, refs)...)
+		}
+	}
+	if anyOf, ok := node["anyOf"].([]any); ok {
+		if _, best := s.branches(anyOf, v, path, refs); best != nil {
+			errs = append(errs, best...)
+		}
+	}
+	if oneOf, ok := node["oneOf"].([]any); ok {
+		matched, best := s.branches(oneOf, v, path, refs)
+		switch {
+		case matched > 1:
+			fail("value matches %d schemas, exactly one is allowed", matched)
+		case matched == 0:
+			errs = append(errs, best...)
+		}
+	}
+	if not, ok := node["not"]; ok && len(s.validate(not, v, path, refs)) == 0 {
+		fail("value matches a schema it must not match")
+	}
+	return errs
+}
+
+func (s *Schema) validateMembers(node map[string]any, obj *orderedjson.Object, path string, refs int) []Error {
+	var errs []Error
+	if required, ok := node["required"].([]any); ok {
+		for _, r := range required {
+			key, _ := r.(string)
+			if _, ok := obj.Get(key); !ok {
+				errs = append(errs, Error{Path: path, Message: fmt.Sprintf("missing required property %q", key)})
+			}
+		}
+	}
+	props, _ := node["properties"].(map[string]any)
+	additional, hasAdditional := node["additionalProperties"]
+	for _, m := range obj.Members {
+		memberPath := path + "." + m.Key
+		if sub, ok := props[m.Key]; ok {
+			errs = append(errs, s.validate(sub, m.Value, memberPath, refs)...)
+			continue
+		}
+		if !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok && !allowed {
+			errs = append(errs, Error{Path: memberPath, Message: fmt.Sprintf("property %q is not allowed", m.Key)})
+			continue
+		}
+		errs = append(errs, s.validate(additional, m.Value, memberPath, refs)...)
+	}
+	return errs
+}
+
+// branches validates v against each alternative of an anyOf or oneOf. It
+// returns how many match and, when none does, the errors of the closest
+// alternative, preferring those whose "type" discriminator matches v, so
+// that an invalid TextBlock reports its bad property rather than "does not
+// match any schema".
+func (s *Schema) branches(alternatives []any, v any, path string, refs int) (int, []Error) {
+	matched := 0
+	var best []Error
+	bestScore := -1
+	for _, alt := range alternatives {
+		errs := s.validate(alt, v, path, refs)
+		if len(errs) == 0 {
+			matched++
+			continue
+		}
+		score := len(errs)
+		for _, e := range errs {
+			if e.wrongType && e.Path == path || e.Path == path+".type" {
+				score += 1000
+			}
+		}
+		if bestScore < 0 || score < bestScore {
+			best, bestScore = errs, score
+		}
+	}
+	if matched > 0 {
+		return matched, nil
+	}
+	if bestScore >= 1000 {
+		desc := typeOf(v)
+		if obj, ok := v.(*orderedjson.Object); ok {
+			if t, ok := obj.Get("type"); ok {
+				desc = show(t)
+			}
+		}
+		return 0, []Error{{Path: path, Message: fmt.Sprintf("%s is not allowed here", desc)}}
+	}
+	return 0, best
+}
+
+func (s *Schema) resolve(ref string) (any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("unsupported schema reference %q", ref)
+	}
+	node := s.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch n := node.(type) {
+		case map[string]any:
+			next, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("unresolved schema reference %q", ref)
+			}
+			node = next
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("unresolved schema reference %q", ref)
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("unresolved schema reference %q", ref)
+		}
+	}
+	return node, nil
+}
+
+func (s *Schema) pattern(p string) (*regexp.Regexp, error) {
+	if re, ok := s.patterns[p]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(p)
+	if err != nil {
+		return nil, err
+	}
+	s.patterns[p] = re
+	return re, nil
+}
+
+func matchesType(t, v any) bool {
+	switch t := t.(type) {
+	case string:
+		return isType(t, v)
+	case []any:
+		for _, name := range t {
+			if s, ok := name.(string); ok && isType(s, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isType(name string, v any) bool {
+	switch name {
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		f, _, err := big.ParseFloat(string(n), 10, 64, big.ToNearestEven)
+		return err == nil && f.IsInt()
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	}
+	return typeOf(v) == name
+}
+
+func typeOf(v any) string {
+	switch v.(type) {
+	case *orderedjson.Object:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func typeNames(t any) string {
+	if list, ok := t.([]any); ok {
+		names := make([]string, len(list))
+		for i, name := range list {
+			names[i] = fmt.Sprint(name)
+		}
+		return strings.Join(names, " or ")
+	}
+	return fmt.Sprint(t)
+}
+
+func number(v any) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+func containsValue(list []any, v any) bool {
+	for _, item := range list {
+		if equal(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal compares a schema value (decoded into maps) with an instance value
+// (decoded by orderedjson).
+func equal(schemaValue, v any) bool {
+	switch sv := schemaValue.(type) {
+	case json.Number:
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		a, _, errA := big.ParseFloat(string(sv), 10, 64, big.ToNearestEven)
+		b, _, errB := big.ParseFloat(string(n), 10, 64, big.ToNearestEven)
+		return errA == nil && errB == nil && a.Cmp(b) == 0
+	case []any:
+		list, ok := v.([]any)
+		if !ok || len(list) != len(sv) {
+			return false
+		}
+		for i := range sv {
+			if !equal(sv[i], list[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		obj, ok := v.(*orderedjson.Object)
+		if !ok || len(obj.Members) != len(sv) {
+			return false
+		}
+		for _, m := range obj.Members {
+			if want, ok := sv[m.Key]; !ok || !equal(want, m.Value) {
+				return false
+			}
+		}
+		return true
+	}
+	return schemaValue == v
+}
+
+func show(v any) string {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return "{" + strings.Join(keys, ", ") + "}"
+	}
+	data, err := orderedjson.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func showList(list []any) string {
+	parts := make([]string, len(list))
+	for i, v := range list {
+		parts[i] = show(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
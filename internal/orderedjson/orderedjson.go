@@ -0,0 +1,190 @@
+// Package orderedjson decodes JSON into a generic tree that, unlike
+// map[string]any, keeps the order of object members, so documents can be
+// rewritten without reshuffling their keys.
+package orderedjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Object is a JSON object with its members in document order.
+type Object struct {
+	Members []Member
+}
+
+type Member struct {
+	Key   string
+	Value any
+}
+
+// Parse decodes data into a tree of *Object, []any, string, json.Number, bool
+// and nil values.
+func Parse(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	v, err := parseValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err == nil {
+		return nil, errors.New("orderedjson: unexpected data after top-level value")
+	}
+	return v, nil
+}
+
+func parseValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := &Object{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, fmt.Errorf("orderedjson: unexpected object key %v", keyTok)
+			}
+			v, err := parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.Members = append(obj.Members, Member{Key: key, Value: v})
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			v, err := parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+	return tok, nil
+}
+
+// Get returns the value of key.
+func (o *Object) Get(key string) (any, bool) {
+	for _, m := range o.Members {
+		if m.Key == key {
+			return m.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Set replaces the value of key, appending the member if it is missing.
+func (o *Object) Set(key string, v any) {
+	for i, m := range o.Members {
+		if m.Key == key {
+			o.Members[i].Value = v
+			return
+		}
+	}
+	o.Members = append(o.Members, Member{Key: key, Value: v})
+}
+
+// Delete removes key from the object.
+func (o *Object) Delete(key string) {
+	for i, m := range o.Members {
+		if m.Key == key {
+			o.Members = append(o.Members[:i], o.Members[i+1:]...)
+			return
+		}
+	}
+}
+
+// MarshalJSON writes the members in order.
+func (o *Object) MarshalJSON() ([]byte, error) {
+	return Marshal(o)
+}
+
+// Marshal encodes a tree produced by Parse. Unlike encoding/json it does not
+// escape <, > and &, which keeps markup such as <at>mentions</at> readable and
+// the output small.
+func Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := write(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalIndent is like Marshal but indents the output.
+func MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	data, err := Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, prefix, indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func write(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case *Object:
+		buf.WriteByte('{')
+		for i, m := range v.Members {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeString(buf, m.Key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := write(buf, m.Value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := write(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case string:
+		return writeString(buf, v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func writeString(buf *bytes.Buffer, s string) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	buf.Truncate(buf.Len() - 1) // Encode appends a newline
+	return nil
+}
@@ -0,0 +1,256 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Invoke names Teams uses for card and messaging extension callbacks.
+const (
+	InvokeAdaptiveCardAction           = "adaptiveCard/action"
+	InvokeComposeExtensionQuery        = "composeExtension/query"
+	InvokeComposeExtensionFetchTask    = "composeExtension/fetchTask"
+	InvokeComposeExtensionSubmitAction = "composeExtension/submitAction"
+	InvokeMessageSubmitAction          = "message/submitAction"
+	InvokeTaskFetch                    = "task/fetch"
+	InvokeTaskSubmit                   = "task/submit"
+)
+
+// InvokeActivity is the subset of a Bot Framework invoke activity needed to
+// route and decode card callbacks.
+type InvokeActivity struct {
+	Type         string              `json:"type"`
+	Name         string              `json:"name"`
+	ID           string              `json:"id,omitempty"`
+	ReplyToID    string              `json:"replyToId,omitempty"`
+	From         ChannelAccount      `json:"from"`
+	Conversation ConversationAccount `json:"conversation"`
+	Value        json.RawMessage     `json:"value,omitempty"`
+}
+
+type ChannelAccount struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	AADObjectID string `json:"aadObjectId,omitempty"`
+}
+
+type ConversationAccount struct {
+	ID               string `json:"id"`
+	ConversationType string `json:"conversationType,omitempty"`
+	TenantID         string `json:"tenantId,omitempty"`
+	IsGroup          bool   `json:"isGroup,omitempty"`
+}
+
+// DecodeInvokeActivity parses the body of an invoke activity.
+func DecodeInvokeActivity(body []byte) (InvokeActivity, error) {
+	var a InvokeActivity
+	if err := json.Unmarshal(body, &a); err != nil {
+		return a, fmt.Errorf("adaptivecard: decoding invoke activity: %w", err)
+	}
+	if a.Type != "invoke" {
+		return a, fmt.Errorf("adaptivecard: activity type %q is not an invoke", a.Type)
+	}
+	return a, nil
+}
+
+// ----------------------
+// adaptiveCard/action
+// ----------------------
+
+// ActionInvokeValue is the value of an adaptiveCard/action invoke, sent when a
+// user presses an Action.Execute or Teams refreshes a card.
+type ActionInvokeValue struct {
+	Action InvokeAction `json:"action"`
+	// Trigger is "manual" for button presses and "automatic" for refreshes.
+	Trigger string `json:"trigger,omitempty"`
+}
+
+type InvokeAction struct {
+	Type string          `json:"type"`
+	ID   string          `json:"id,omitempty"`
+	Verb string          `json:"verb,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+func DecodeActionInvoke(value []byte) (ActionInvokeValue, error) {
+	var v ActionInvokeValue
+	if err := json.Unmarshal(value, &v); err != nil {
+		return v, fmt.Errorf("adaptivecard: decoding %s value: %w", InvokeAdaptiveCardAction, err)
+	}
+	return v, nil
+}
+
+// DecodeData decodes the action's data (including input values) into v.
+func (a InvokeAction) DecodeData(v any) error {
+	if len(a.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(a.Data, v); err != nil {
+		return fmt.Errorf("adaptivecard: decoding data of action %q: %w", a.Verb, err)
+	}
+	return nil
+}
+
+// Content types of an adaptiveCard/action response value.
+const (
+	InvokeResponseCard    = ContentType
+	InvokeResponseMessage = "application/vnd.microsoft.activity.message"
+	InvokeResponseError   = "application/vnd.microsoft.error"
+)
+
+// ActionInvokeResponse is the reply to an adaptiveCard/action invoke: a card
+// replacing the one the user acted on, a toast message, or an error.
+type ActionInvokeResponse struct {
+	StatusCode int    `json:"statusCode"`
+	Type       string `json:"type"`
+	Value      any    `json:"value"`
+}
+
+// InvokeError is the value of an error ActionInvokeResponse.
+type InvokeError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewCardResponse(card AdaptiveCard) ActionInvokeResponse {
+	return ActionInvokeResponse{StatusCode: 200, Type: InvokeResponseCard, Value: card}
+}
+
+func NewMessageResponse(message string) ActionInvokeResponse {
+	return ActionInvokeResponse{StatusCode: 200, Type: InvokeResponseMessage, Value: message}
+}
+
+// NewErrorResponse reports a failed action; statusCode is 400 or 500 and code
+// a short machine-readable reason such as "BadRequest".
+func NewErrorResponse(statusCode int, code, message string) ActionInvokeResponse {
+	return ActionInvokeResponse{
+		StatusCode: statusCode,
+		Type:       InvokeResponseError,
+		Value:      InvokeError{Code: code, Message: message},
+	}
+}
+
+// ----------------------
+// composeExtension
+// ----------------------
+
+// ComposeExtensionQuery is the value of a composeExtension/query invoke sent by
+// search-based messaging extensions.
+type ComposeExtensionQuery struct {
+	CommandID    string           `json:"commandId"`
+	Parameters   []QueryParameter `json:"parameters"`
+	QueryOptions *QueryOptions    `json:"queryOptions,omitempty"`
+}
+
+type QueryParameter struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+type QueryOptions struct {
+	Skip  int `json:"skip"`
+	Count int `json:"count"`
+}
+
+func DecodeComposeExtensionQuery(value []byte) (ComposeExtensionQuery, error) {
+	var q ComposeExtensionQuery
+	if err := json.Unmarshal(value, &q); err != nil {
+		return q, fmt.Errorf("adaptivecard: decoding %s value: %w", InvokeComposeExtensionQuery, err)
+	}
+	return q, nil
+}
+
+// Parameter returns the value of the named query parameter as a string.
+func (q ComposeExtensionQuery) Parameter(name string) (string, bool) {
+	for _, p := range q.Parameters {
+		if p.Name == name {
+			if s, ok := p.Value.(string); ok {
+				return s, true
+			}
+			return fmt.Sprint(p.Value), true
+		}
+	}
+	return "", false
+}
+
+// ComposeExtensionAction is the value of composeExtension/fetchTask and
+// composeExtension/submitAction invokes sent by action-based messaging
+// extensions.
+type ComposeExtensionAction struct {
+	CommandID string `json:"commandId"`
+	// CommandContext is "message", "compose" or "commandbox".
+	CommandContext string `json:"commandContext,omitempty"`
+	// BotMessagePreviewAction is "edit" or "send" when the user acts on a
+	// preview card.
+	BotMessagePreviewAction string                   `json:"botMessagePreviewAction,omitempty"`
+	Data                    json.RawMessage          `json:"data,omitempty"`
+	Context                 *ComposeExtensionContext `json:"context,omitempty"`
+	MessagePayload          *MessagePayload          `json:"messagePayload,omitempty"`
+}
+
+type ComposeExtensionContext struct {
+	Theme string `json:"theme,omitempty"`
+}
+
+// MessagePayload describes the message an action was invoked on when
+// CommandContext is "message".
+type MessagePayload struct {
+	ID              string             `json:"id"`
+	CreatedDateTime string             `json:"createdDateTime,omitempty"`
+	LinkToMessage   string             `json:"linkToMessage,omitempty"`
+	Body            MessagePayloadBody `json:"body"`
+	From            *struct {
+		User *struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"displayName"`
+		} `json:"user,omitempty"`
+	} `json:"from,omitempty"`
+}
+
+type MessagePayloadBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+func DecodeComposeExtensionAction(value []byte) (ComposeExtensionAction, error) {
+	var a ComposeExtensionAction
+	if err := json.Unmarshal(value, &a); err != nil {
+		return a, fmt.Errorf("adaptivecard: decoding composeExtension action value: %w", err)
+	}
+	return a, nil
+}
+
+// DecodeData decodes the submitted task module data into v.
+func (a ComposeExtensionAction) DecodeData(v any) error {
+	if len(a.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(a.Data, v); err != nil {
+		return fmt.Errorf("adaptivecard: decoding data of command %q: %w", a.CommandID, err)
+	}
+	return nil
+}
+
+// ----------------------
+// message/submitAction (feedback)
+// ----------------------
+
+// FeedbackInvokeValue is the value of the message/submitAction invoke Teams
+// sends when a user submits feedback on a message with EnableFeedback.
+type FeedbackInvokeValue struct {
+	ActionName  string `json:"actionName"`
+	ActionValue struct {
+		// Reaction is "like" or "dislike".
+		Reaction string `json:"reaction"`
+		// Feedback is the JSON encoded form content, e.g. {"feedbackText":"..."}.
+		Feedback string `json:"feedback"`
+	} `json:"actionValue"`
+}
+
+func DecodeFeedbackInvoke(value []byte) (FeedbackInvokeValue, error) {
+	var v FeedbackInvokeValue
+	if err := json.Unmarshal(value, &v); err != nil {
+		return v, fmt.Errorf("adaptivecard: decoding %s value: %w", InvokeMessageSubmitAction, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,167 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// Defaults for FromJSONObject.
+const (
+	DefaultJSONMaxDepth = 3
+	DefaultJSONMaxKeys  = 25
+	maxJSONValueLen     = 200
+)
+
+// JSONObjectOption configures FromJSONObject.
+type JSONObjectOption func(*jsonObjectOptions)
+
+type jsonObjectOptions struct {
+	maxDepth int
+	maxKeys  int
+}
+
+// JSONMaxDepth sets how many levels of nested objects get their own
+// container; deeper values are shown as compact JSON.
+func JSONMaxDepth(n int) JSONObjectOption {
+	return func(o *jsonObjectOptions) { o.maxDepth = n }
+}
+
+// JSONMaxKeys limits the members shown per object; the rest are summarized
+// in a final "…" fact.
+func JSONMaxKeys(n int) JSONObjectOption {
+	return func(o *jsonObjectOptions) { o.maxKeys = n }
+}
+
+// FromJSONObject renders a JSON object as a Container: scalar members become
+// a FactSet, nested objects and arrays of objects become indented containers
+// headed by their key. Member order follows the document.
+func FromJSONObject(raw json.RawMessage, opts ...JSONObjectOption) (Container, error) {
+	o := jsonObjectOptions{maxDepth: DefaultJSONMaxDepth, maxKeys: DefaultJSONMaxKeys}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	v, err := orderedjson.Parse(raw)
+	if err != nil {
+		return Container{}, fmt.Errorf("parsing json object: %w", err)
+	}
+	obj, ok := v.(*orderedjson.Object)
+	if !ok {
+		return Container{}, errors.New("parsing json object: top-level value is not an object")
+	}
+	return o.object(obj, 1), nil
+}
+
+func (o jsonObjectOptions) object(obj *orderedjson.Object, depth int) Container {
+	container := NewContainer()
+	var facts []Fact
+	flush := func() {
+		if len(facts) > 0 {
+			container.AddItem(NewFactSet(facts...))
+			facts = nil
+		}
+	}
+
+	members := obj.Members
+	hidden := 0
+	if o.maxKeys > 0 && len(members) > o.maxKeys {
+		hidden = len(members) - o.maxKeys
+		members = members[:o.maxKeys]
+	}
+
+	for _, m := range members {
+		if nested, ok := o.nested(m.Key, m.Value, depth); ok {
+			flush()
+			container.AddItem(nested)
+			continue
+		}
+		facts = append(facts, Fact{Title: m.Key, Value: jsonValueText(m.Value)})
+	}
+	if hidden > 0 {
+		facts = append(facts, Fact{Title: "…", Value: plural(hidden, "more key")})
+	}
+	flush()
+	return container
+}
+
+// nested renders objects and arrays of objects as a headed container, as long
+// as the depth limit allows.
+func (o jsonObjectOptions) nested(key string, v any, depth int) (Element, bool) {
+	if depth >= o.maxDepth {
+		return nil, false
+	}
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		return o.section(key, o.object(v, depth+1)), true
+	case []any:
+		if len(v) == 0 {
+			return nil, false
+		}
+		items := NewContainer()
+		for i, item := range v {
+			obj, ok := item.(*orderedjson.Object)
+			if !ok {
+				return nil, false
+			}
+			items.AddItem(o.section(fmt.Sprintf("[%d]", i), o.object(obj, depth+1)))
+		}
+		return o.section(key, items), true
+	}
+	return nil, false
+}
+
+func (o jsonObjectOptions) section(title string, content Container) Container {
+	heading := NewTextBlock(title)
+	heading.WithWeight(WeightBolder)
+
+	section := NewContainer(heading, content)
+	section.WithSeparator()
+	return section
+}
+
+// jsonValueText renders a scalar as plain text and anything else as compact
+// JSON, truncated so a single value cannot dominate the card.
+func jsonValueText(v any) string {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		s = "null"
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case bool:
+		s = fmt.Sprint(v)
+	case []any:
+		if scalars, ok := scalarList(v); ok {
+			s = strings.Join(scalars, ", ")
+			break
+		}
+		b, _ := orderedjson.Marshal(v)
+		s = string(b)
+	default:
+		b, _ := orderedjson.Marshal(v)
+		s = string(b)
+	}
+	if utf8.RuneCountInString(s) > maxJSONValueLen {
+		s = string([]rune(s)[:maxJSONValueLen-1]) + "…"
+	}
+	return s
+}
+
+func scalarList(items []any) ([]string, bool) {
+	out := make([]string, len(items))
+	for i, item := range items {
+		switch item.(type) {
+		case *orderedjson.Object, []any:
+			return nil, false
+		}
+		out[i] = jsonValueText(item)
+	}
+	return out, true
+}
@@ -0,0 +1,70 @@
+package adaptivecard
+
+import (
+	"unicode/utf8"
+)
+
+// SetFullWidth stretches the card across the whole message pane in Teams
+// instead of the default fixed width.
+func (c *AdaptiveCard) SetFullWidth() {
+	if c.MSTeams == nil {
+		c.MSTeams = &MSTeamsInfo{}
+	}
+	c.MSTeams.Width = "full"
+}
+
+// SetFullWidthLayout calls SetFullWidth and adapts the body to the extra room:
+// tables whose columns all share the same width are given widths proportional
+// to the longest text in each column, so short columns (status, counts) stop
+// taking as much space as long ones (descriptions, URLs). Tables with explicit,
+// unequal widths are left alone.
+func (c *AdaptiveCard) SetFullWidthLayout() {
+	c.SetFullWidth()
+	c.Body = transform(c.Body, func(el Element) Element {
+		if t, ok := el.(Table); ok {
+			t.balanceColumns()
+			return t
+		}
+		return el
+	})
+}
+
+const (
+	minColumnWeight = 4
+	maxColumnWeight = 40
+)
+
+func (t *Table) balanceColumns() {
+	cols := len(t.Columns)
+	if cols == 0 {
+		for _, r := range t.Rows {
+			cols = max(cols, len(r.Cells))
+		}
+	}
+	for _, col := range t.Columns {
+		if col.Width != t.Columns[0].Width {
+			return
+		}
+	}
+
+	widths := make([]int, cols)
+	for _, r := range t.Rows {
+		for j, cell := range r.Cells {
+			if j >= cols {
+				break
+			}
+			for _, item := range cell.Items {
+				if tb, ok := unwrapConditional(item).(TextBlock); ok {
+					widths[j] = max(widths[j], utf8.RuneCountInString(tb.Text))
+				}
+			}
+		}
+	}
+
+	columns := make([]TableCol, cols)
+	copy(columns, t.Columns)
+	for j, w := range widths {
+		columns[j].Width = min(max(w, minColumnWeight), maxColumnWeight)
+	}
+	t.Columns = columns
+}
@@ -0,0 +1,65 @@
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxActionsTeams is the number of primary actions Teams renders in one action
+// row; further actions are silently dropped.
+const MaxActionsTeams = 6
+
+// ErrTooManyActions is returned (wrapped) when an action row exceeds the host
+// limit.
+var ErrTooManyActions = errors.New("adaptivecard: too many actions")
+
+// EnforceActionLimit checks that the card's top-level action row and every
+// ActionSet have at most limit primary actions. With overflowToSecondary,
+// actions past the limit are moved to the secondary ("...") menu instead,
+// which requires version 1.5.
+func (c *AdaptiveCard) EnforceActionLimit(limit int, overflowToSecondary bool) error {
+	actions, err := enforceActionLimit(c.Actions, "$.actions", limit, overflowToSecondary)
+	if err != nil {
+		return err
+	}
+	body := transformPaths(c.Body, "$.body", func(path string, el Element) Element {
+		as, ok := el.(ActionSet)
+		if !ok || err != nil {
+			return el
+		}
+		as.Actions, err = enforceActionLimit(as.Actions, path+".actions", limit, overflowToSecondary)
+		return as
+	})
+	if err != nil {
+		return err
+	}
+	c.Actions = actions
+	c.Body = body
+	return nil
+}
+
+// enforceActionLimit returns a copy of actions with the overflow moved to
+// the secondary menu.
+func enforceActionLimit(actions []ActionElement, path string, limit int, overflowToSecondary bool) ([]ActionElement, error) {
+	primary := 0
+	var err error
+	out := mapActions(actions, func(a Action) Action {
+		if a.Mode == "secondary" || err != nil {
+			return a
+		}
+		primary++
+		if primary <= limit {
+			return a
+		}
+		if !overflowToSecondary {
+			err = fmt.Errorf("%w: %s has more than %d primary actions", ErrTooManyActions, path, limit)
+			return a
+		}
+		a.Mode = "secondary"
+		return a
+	})
+	if err != nil {
+		return actions, err
+	}
+	return out, nil
+}
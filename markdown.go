@@ -0,0 +1,89 @@
+package adaptivecard
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	mdHeading    = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)\s*#*\s*$`)
+	mdQuote      = regexp.MustCompile(`^\s{0,3}>\s?`)
+	mdRule       = regexp.MustCompile(`^\s{0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	mdFence      = regexp.MustCompile("^\\s{0,3}(```|~~~)")
+	mdBullet     = regexp.MustCompile(`^\s*[-*+]\s+`)
+	mdNumbered   = regexp.MustCompile(`^\s*(\d+)[.)]\s+`)
+	mdTableRule  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	mdImage      = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+	mdInlineCode = regexp.MustCompile("`+([^`]*)`+")
+	mdStrike     = regexp.MustCompile(`~~(.*?)~~`)
+	mdHTMLTag    = regexp.MustCompile(`</?([a-zA-Z][a-zA-Z0-9]*)\b[^>]*>`)
+)
+
+// SanitizeMarkdown rewrites arbitrary (CommonMark/GitHub flavoured) markdown
+// into the subset TextBlocks render in Teams: bold, italic, links and flat
+// bulleted or numbered lists. Headings become bold lines, nested lists are
+// flattened, images become links, and code fences, block quotes, rules,
+// tables, strikethrough and HTML tags (except <at> mentions) are reduced to
+// their plain text, so upstream content does not show up as stray symbols.
+func SanitizeMarkdown(md string) string {
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	inFence := false
+	for _, line := range lines {
+		if mdFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+		if mdRule.MatchString(line) {
+			continue
+		}
+		line = mdQuote.ReplaceAllString(line, "")
+
+		switch {
+		case mdHeading.MatchString(line):
+			line = "**" + sanitizeInline(mdHeading.FindStringSubmatch(line)[1]) + "**"
+		case mdTableRule.MatchString(line) && strings.Contains(line, "-") && strings.Contains(line, "|"):
+			continue
+		case strings.HasPrefix(strings.TrimSpace(line), "|"):
+			cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+			for i := range cells {
+				cells[i] = sanitizeInline(strings.TrimSpace(cells[i]))
+			}
+			line = strings.Join(cells, " · ")
+		case mdBullet.MatchString(line):
+			line = "- " + sanitizeInline(mdBullet.ReplaceAllString(line, ""))
+		case mdNumbered.MatchString(line):
+			m := mdNumbered.FindStringSubmatch(line)
+			line = m[1] + ". " + sanitizeInline(mdNumbered.ReplaceAllString(line, ""))
+		default:
+			line = sanitizeInline(strings.TrimLeft(line, " \t"))
+		}
+		out = append(out, line)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+// sanitizeInline rewrites unsupported inline syntax within one line.
+func sanitizeInline(s string) string {
+	s = mdImage.ReplaceAllStringFunc(s, func(m string) string {
+		parts := mdImage.FindStringSubmatch(m)
+		alt := parts[1]
+		if alt == "" {
+			alt = "image"
+		}
+		return "[" + alt + "](" + parts[2] + ")"
+	})
+	s = mdInlineCode.ReplaceAllString(s, "$1")
+	s = mdStrike.ReplaceAllString(s, "$1")
+	s = mdHTMLTag.ReplaceAllStringFunc(s, func(tag string) string {
+		if strings.EqualFold(mdHTMLTag.FindStringSubmatch(tag)[1], "at") {
+			return tag
+		}
+		return ""
+	})
+	return s
+}
@@ -0,0 +1,53 @@
+package adaptivecard
+
+// ----------------------
+// Media
+// ----------------------
+type Media struct {
+	Type string `json:"type"`
+	BaseElement
+	Sources        []MediaSource   `json:"sources"`
+	Poster         string          `json:"poster,omitempty"`
+	AltText        string          `json:"altText,omitempty"`
+	CaptionSources []CaptionSource `json:"captionSources,omitempty"`
+}
+
+type MediaSource struct {
+	MimeType string `json:"mimeType,omitempty"`
+	URL      string `json:"url"`
+}
+
+// CaptionSource points at a caption track (e.g. WebVTT) for a Media element.
+type CaptionSource struct {
+	Label    string `json:"label"`
+	MimeType string `json:"mimeType"`
+	URL      string `json:"url"`
+}
+
+func NewMedia(sources ...MediaSource) Media {
+	return Media{
+		Type:    "Media",
+		Sources: sources,
+	}
+}
+func (Media) isElement() {}
+func (m Media) toRaw() any {
+	return m
+}
+
+// WithPoster sets the image shown before the media is played.
+func (m *Media) WithPoster(url string) {
+	m.Poster = url
+}
+
+func (m *Media) WithAltText(altText string) {
+	m.AltText = altText
+}
+
+func (m *Media) AddCaptionSource(label, mimeType, url string) {
+	m.CaptionSources = append(m.CaptionSources, CaptionSource{
+		Label:    label,
+		MimeType: mimeType,
+		URL:      url,
+	})
+}
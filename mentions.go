@@ -0,0 +1,315 @@
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxInlineMentions is the number of users MentionAll mentions individually;
+// the rest are summarised as "and N others" to keep the card small.
+const MaxInlineMentions = 20
+
+// MentionAll appends a TextBlock mentioning users, e.g. "<at>Ana</at>,
+// <at>Ben</at> and 12 others", and adds one msteams entity per mentioned user.
+// Users are de-duplicated by ID, only the first MaxInlineMentions are
+// mentioned individually, and users already present as entities are not
+// added twice. It returns an error, leaving the card unchanged, if two
+// different users would share a placeholder, either with each other or with
+// an existing entity: Teams resolves placeholders by text, so one of them
+// would be mentioned in place of the other.
+func (c *AdaptiveCard) MentionAll(users []Mention) error {
+	seen := make(map[string]bool, len(users))
+	unique := make([]Mention, 0, len(users))
+	for _, u := range users {
+		if seen[u.ID] {
+			continue
+		}
+		seen[u.ID] = true
+		unique = append(unique, u)
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	mentioned := unique[:min(len(unique), MaxInlineMentions)]
+	others := len(unique) - len(mentioned)
+
+	ids := make(map[string]string, len(mentioned))
+	for _, u := range mentioned {
+		if err := checkMentionName(u.Name); err != nil {
+			return err
+		}
+		if err := c.checkMentionEntity(u); err != nil {
+			return err
+		}
+		token := mentionToken(u.Name)
+		if id, ok := ids[token]; ok {
+			return fmt.Errorf("adaptivecard: %s would mention both %s and %s", token, id, u.ID)
+		}
+		ids[token] = u.ID
+	}
+
+	parts := make([]string, len(mentioned))
+	for i, u := range mentioned {
+		parts[i] = mentionToken(u.Name)
+		c.addMentionEntity(u)
+	}
+	c.AddBody(NewTextBlock(joinList(parts, others)))
+	return nil
+}
+
+// AddUserMention mentions a user in the card's last body element, which must
+// be a TextBlock (one is added otherwise): it appends the <at>displayName</at>
+// placeholder to the text and adds the matching msteams entity. userID is the
+// user's Azure AD object ID or UPN. It returns an error, leaving the card
+// unchanged, if the placeholder would be ambiguous or not match the entity.
+func (c *AdaptiveCard) AddUserMention(displayName, userID string) error {
+	m, err := userMention(displayName, userID)
+	if err != nil {
+		return err
+	}
+	return c.addMention(m)
+}
+
+// AddChannelMention mentions a channel, notifying everyone following it, as
+// AddUserMention does for users. channelID is the channel's conversation ID,
+// e.g. "19:abc@thread.tacv2".
+func (c *AdaptiveCard) AddChannelMention(displayName, channelID string) error {
+	m, err := conversationMention(displayName, channelID, "channel")
+	if err != nil {
+		return err
+	}
+	return c.addMention(m)
+}
+
+// AddTeamMention mentions a whole team, notifying every member. teamID is the
+// conversation ID of the team's General channel, e.g. "19:abc@thread.tacv2".
+func (c *AdaptiveCard) AddTeamMention(displayName, teamID string) error {
+	m, err := conversationMention(displayName, teamID, "team")
+	if err != nil {
+		return err
+	}
+	return c.addMention(m)
+}
+
+// AddTagMention mentions a Teams tag, notifying every member it is assigned
+// to, as AddUserMention does for users. tagID is the tag's ID from Microsoft
+// Graph (teamworkTag.id).
+func (c *AdaptiveCard) AddTagMention(tagID, displayName string) error {
+	if err := checkMentionName(displayName); err != nil {
+		return err
+	}
+	if strings.TrimSpace(tagID) == "" {
+		return errors.New("adaptivecard: tag ID is empty")
+	}
+	return c.addMention(Mention{ID: tagID, Name: displayName, Type: "tag"})
+}
+
+// addMention appends the placeholder for m to the last body element, or to a
+// new TextBlock, and adds its entity.
+func (c *AdaptiveCard) addMention(m Mention) error {
+	if err := c.checkMentionEntity(m); err != nil {
+		return err
+	}
+	last := len(c.Body) - 1
+	tb, ok := TextBlock{}, false
+	if last >= 0 {
+		tb, ok = c.Body[last].(TextBlock)
+	}
+	if !ok {
+		c.AddBody(NewTextBlock(mentionToken(m.Name)))
+	} else {
+		tb.Text = appendMention(tb.Text, m.Name)
+		c.Body[last] = tb
+	}
+	c.addMentionEntity(m)
+	return nil
+}
+
+// AddUserMentionTo is AddUserMention for the TextBlock with the given
+// element ID, which may be nested anywhere in the body.
+func (c *AdaptiveCard) AddUserMentionTo(textBlockID, displayName, userID string) error {
+	m, err := userMention(displayName, userID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkMentionEntity(m); err != nil {
+		return err
+	}
+	found := false
+	body := transform(c.Body, func(el Element) Element {
+		if tb, ok := el.(TextBlock); ok && tb.ID == textBlockID && !found {
+			found = true
+			tb.Text = appendMention(tb.Text, m.Name)
+			return tb
+		}
+		return el
+	})
+	if !found {
+		return fmt.Errorf("adaptivecard: no TextBlock with id %q", textBlockID)
+	}
+	c.Body = body
+	c.addMentionEntity(m)
+	return nil
+}
+
+var guidRE = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// userMention checks the arguments of AddUserMention. Besides Azure AD object
+// IDs and UPNs, Teams also accepts Bot Framework user IDs ("29:...").
+func userMention(displayName, userID string) (Mention, error) {
+	if err := checkMentionName(displayName); err != nil {
+		return Mention{}, err
+	}
+	if !guidRE.MatchString(userID) && !strings.Contains(userID, "@") && !strings.HasPrefix(userID, "29:") {
+		return Mention{}, fmt.Errorf("adaptivecard: %q is not an Azure AD object ID or UPN", userID)
+	}
+	return Mention{ID: userID, Name: displayName}, nil
+}
+
+// conversationMention checks the arguments of AddChannelMention and
+// AddTeamMention; kind is the mention's conversationIdentityType.
+func conversationMention(displayName, conversationID, kind string) (Mention, error) {
+	if err := checkMentionName(displayName); err != nil {
+		return Mention{}, err
+	}
+	if !strings.HasPrefix(conversationID, "19:") || !strings.Contains(conversationID, "@thread.") {
+		return Mention{}, fmt.Errorf("adaptivecard: %q is not a Teams %s ID (19:...@thread...)", conversationID, kind)
+	}
+	return Mention{ID: conversationID, Name: displayName, ConversationIdentityType: kind}, nil
+}
+
+// checkMentionName reports display names that cannot be used in a
+// placeholder.
+func checkMentionName(displayName string) error {
+	switch {
+	case strings.TrimSpace(displayName) == "":
+		return errors.New("adaptivecard: mention display name is empty")
+	case strings.ContainsAny(displayName, "<>"):
+		return fmt.Errorf("adaptivecard: mention display name %q must not contain < or >", displayName)
+	}
+	return nil
+}
+
+// checkMentionEntity reports an error if the card already has an entity for
+// the same placeholder but a different user: Teams resolves placeholders by
+// text, so one of the two mentions would point at the wrong person.
+func (c *AdaptiveCard) checkMentionEntity(m Mention) error {
+	if c.MSTeams == nil {
+		return nil
+	}
+	token := mentionToken(m.Name)
+	for _, e := range c.MSTeams.Entities {
+		if e.Type == "mention" && e.Text == token && e.Mentioned.ID != m.ID {
+			return fmt.Errorf("adaptivecard: %s already mentions %s", token, e.Mentioned.ID)
+		}
+	}
+	return nil
+}
+
+// appendMention appends the placeholder for name to text, separated by a
+// space.
+func appendMention(text, name string) string {
+	if text == "" || strings.HasSuffix(text, " ") {
+		return text + mentionToken(name)
+	}
+	return text + " " + mentionToken(name)
+}
+
+// mentionToken is the placeholder Teams replaces with a mention of name.
+func mentionToken(name string) string {
+	return fmt.Sprintf("<at>%s</at>", name)
+}
+
+// addMentionEntity adds a mention entity for m unless one with the same ID
+// and text already exists.
+func (c *AdaptiveCard) addMentionEntity(m Mention) {
+	if c.MSTeams == nil {
+		c.MSTeams = &MSTeamsInfo{}
+	}
+	entity := MSTeamsEntity{
+		Type:      "mention",
+		Text:      mentionToken(m.Name),
+		Mentioned: m,
+	}
+	for _, e := range c.MSTeams.Entities {
+		if e == entity {
+			return
+		}
+	}
+	c.MSTeams.Entities = append(c.MSTeams.Entities, entity)
+}
+
+// joinList joins items as "a, b and c", summarising others as
+// "a, b and 3 others".
+func joinList(items []string, others int) string {
+	switch {
+	case others == 1:
+		items = append(items[:len(items):len(items)], "1 other")
+	case others > 1:
+		items = append(items[:len(items):len(items)], fmt.Sprintf("%d others", others))
+	}
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+var mentionTokenRE = regexp.MustCompile(`<at>(.*?)</at>`)
+
+// ValidateMentions checks that every <at>Name</at> placeholder in the card's
+// text has a matching msteams mention entity and that every entity is used by
+// at least one placeholder. Teams renders unmatched placeholders as raw text.
+// Validate reports the same problems with rule RuleMention.
+func (c AdaptiveCard) ValidateMentions() error {
+	var errs []error
+	for _, e := range c.mentionErrors() {
+		errs = append(errs, e)
+	}
+	return errors.Join(errs...)
+}
+
+func (c AdaptiveCard) mentionErrors() []ValidationError {
+	var entities []MSTeamsEntity
+	if c.MSTeams != nil {
+		entities = c.MSTeams.Entities
+	}
+	known := make(map[string]bool, len(entities))
+	for _, e := range entities {
+		if e.Type == "mention" {
+			known[e.Text] = true
+		}
+	}
+
+	var errs []ValidationError
+	add := func(path, format string, args ...any) {
+		errs = append(errs, ValidationError{Path: path, Rule: RuleMention, Message: fmt.Sprintf(format, args...)})
+	}
+	used := make(map[string]bool)
+	walk(resolveConditionals(c.Body), "$.body", func(path string, el Element) error {
+		elementTexts(path, el, func(path, text string) {
+			for _, token := range mentionTokenRE.FindAllString(text, -1) {
+				used[token] = true
+				if !known[token] {
+					add(path, "%s has no matching mention entity", token)
+				}
+			}
+		})
+		return nil
+	})
+	for i, e := range entities {
+		if e.Type != "mention" {
+			continue
+		}
+		path := fmt.Sprintf("$.msteams.entities[%d]", i)
+		if !used[e.Text] {
+			add(path, "%s is not used in any text", e.Text)
+		}
+		if e.Mentioned.ID == "" {
+			add(path+".mentioned.id", "%s has no mentioned id", e.Text)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,74 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMentionAll(t *testing.T) {
+	ana := Mention{ID: "ana@example.com", Name: "Ana"}
+	tests := []struct {
+		name     string
+		existing []Mention
+		users    []Mention
+		wantErr  string
+		wantText string
+	}{
+		{
+			name:     "duplicate IDs are mentioned once",
+			users:    []Mention{ana, ana, {ID: "ben@example.com", Name: "Ben"}},
+			wantText: "<at>Ana</at> and <at>Ben</at>",
+		},
+		{
+			name:    "same name, different IDs",
+			users:   []Mention{ana, {ID: "ana2@example.com", Name: "Ana"}},
+			wantErr: "would mention both",
+		},
+		{
+			name:     "same name as an existing entity, different ID",
+			existing: []Mention{{ID: "ana2@example.com", Name: "Ana"}},
+			users:    []Mention{ana},
+			wantErr:  "already mentions",
+		},
+		{
+			name:     "existing entity for the same user",
+			existing: []Mention{ana},
+			users:    []Mention{ana},
+			wantText: "<at>Ana</at>",
+		},
+		{
+			name:    "invalid name",
+			users:   []Mention{{ID: "x@example.com", Name: "<b>"}},
+			wantErr: "must not contain",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := newTestCard()
+			for _, m := range tt.existing {
+				card.addMentionEntity(m)
+			}
+			before := mustMarshal(t, card)
+			err := card.MentionAll(tt.users)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("MentionAll() error = %v, want %q", err, tt.wantErr)
+				}
+				if after := mustMarshal(t, card); after != before {
+					t.Errorf("card changed on error:\n%s\n%s", before, after)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			tb := card.Body[len(card.Body)-1].(TextBlock)
+			if tb.Text != tt.wantText {
+				t.Errorf("text = %q, want %q", tb.Text, tt.wantText)
+			}
+			if err := card.ValidateMentions(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package adaptivecard
+
+// Attachment layouts of a messaging extension result.
+const (
+	AttachmentLayoutList = "list"
+	AttachmentLayoutGrid = "grid"
+)
+
+// ThumbnailContentType is the content type of a ThumbnailCard preview.
+const ThumbnailContentType = "application/vnd.microsoft.card.thumbnail"
+
+// MessagingExtensionResponse is the reply to a composeExtension/query invoke.
+type MessagingExtensionResponse struct {
+	ComposeExtension MessagingExtensionResult `json:"composeExtension"`
+}
+
+type MessagingExtensionResult struct {
+	Type             string                         `json:"type"`
+	AttachmentLayout string                         `json:"attachmentLayout,omitempty"`
+	Attachments      []MessagingExtensionAttachment `json:"attachments,omitempty"`
+	Text             string                         `json:"text,omitempty"`
+}
+
+// MessagingExtensionAttachment is one search result: the card inserted into
+// the compose box, and the preview shown in the result list.
+type MessagingExtensionAttachment struct {
+	Attachment
+	Preview *Preview `json:"preview,omitempty"`
+}
+
+// Preview is the result list entry: a ThumbnailCard or an AdaptiveCard.
+type Preview struct {
+	ContentType string `json:"contentType"`
+	Content     any    `json:"content"`
+}
+
+// ThumbnailCard is the compact card Teams uses for result list previews.
+type ThumbnailCard struct {
+	Title    string      `json:"title,omitempty"`
+	Subtitle string      `json:"subtitle,omitempty"`
+	Text     string      `json:"text,omitempty"`
+	Images   []CardImage `json:"images,omitempty"`
+}
+
+type CardImage struct {
+	URL string `json:"url"`
+	Alt string `json:"alt,omitempty"`
+}
+
+// NewSearchResponse returns a result list in the given layout
+// (AttachmentLayoutList or AttachmentLayoutGrid).
+func NewSearchResponse(layout string, results ...MessagingExtensionAttachment) MessagingExtensionResponse {
+	return MessagingExtensionResponse{ComposeExtension: MessagingExtensionResult{
+		Type:             "result",
+		AttachmentLayout: layout,
+		Attachments:      results,
+	}}
+}
+
+// NewSearchMessageResponse shows message instead of results, e.g. "No
+// matches".
+func NewSearchMessageResponse(message string) MessagingExtensionResponse {
+	return MessagingExtensionResponse{ComposeExtension: MessagingExtensionResult{
+		Type: "message",
+		Text: message,
+	}}
+}
+
+// NewSearchResult pairs card with a thumbnail preview.
+func NewSearchResult(card AdaptiveCard, preview ThumbnailCard) MessagingExtensionAttachment {
+	return MessagingExtensionAttachment{
+		Attachment: NewAttachment(card),
+		Preview:    &Preview{ContentType: ThumbnailContentType, Content: preview},
+	}
+}
+
+// NewCardSearchResult pairs card with a smaller adaptive card preview.
+func NewCardSearchResult(card, preview AdaptiveCard) MessagingExtensionAttachment {
+	return MessagingExtensionAttachment{
+		Attachment: NewAttachment(card),
+		Preview:    &Preview{ContentType: ContentType, Content: preview},
+	}
+}
@@ -0,0 +1,236 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Middleware transforms a card right before it is serialized. It receives a
+// copy of the card: replacing body elements or actions does not affect the
+// original, but nested slices are shared, so middleware should rebuild
+// rather than modify them in place.
+type Middleware func(*AdaptiveCard) error
+
+// Use registers middleware to run, in order, every time the card is
+// marshaled. Typical uses are emoji substitution, rewriting links through a
+// click tracker, or redacting secrets (see MapText and MapURLs).
+func (c *AdaptiveCard) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// applyMiddleware returns a copy of c with all registered middleware applied.
+func (c AdaptiveCard) applyMiddleware() (AdaptiveCard, error) {
+	if len(c.middleware) == 0 {
+		return c, nil
+	}
+	c.Body = slices.Clone(c.Body)
+	c.Actions = slices.Clone(c.Actions)
+	if c.MSTeams != nil {
+		teams := *c.MSTeams
+		teams.Entities = slices.Clone(teams.Entities)
+		c.MSTeams = &teams
+	}
+	for _, m := range c.middleware {
+		if err := m(&c); err != nil {
+			return c, fmt.Errorf("adaptivecard: middleware: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// MapText returns middleware that passes every user-visible string through
+// fn: text blocks, text runs, facts, badges, image and media alt text, input
+// labels, placeholders, error messages and choices, action titles, and the
+// card's fallbackText and speak. It reaches fallback elements and the cards
+// revealed by Action.ShowCard.
+func MapText(fn func(string) string) Middleware {
+	var m Middleware
+	mapTitle := func(a Action) Action {
+		a.Title = fn(a.Title)
+		return mapShowCard(a, m)
+	}
+	mapInput := func(in InputFields) InputFields {
+		in.Label = fn(in.Label)
+		in.ErrorMessage = fn(in.ErrorMessage)
+		return in
+	}
+	m = func(c *AdaptiveCard) error {
+		c.Body = transformAll(c.Body, func(el Element) Element {
+			switch el := el.(type) {
+			case TextBlock:
+				el.Text = fn(el.Text)
+				return el
+			case RichTextBlock:
+				el.Inlines = slices.Clone(el.Inlines)
+				for i := range el.Inlines {
+					el.Inlines[i].Text = fn(el.Inlines[i].Text)
+				}
+				return el
+			case FactSet:
+				el.Facts = slices.Clone(el.Facts)
+				for i := range el.Facts {
+					el.Facts[i].Title = fn(el.Facts[i].Title)
+					el.Facts[i].Value = fn(el.Facts[i].Value)
+				}
+				return el
+			case Badge:
+				el.Text = fn(el.Text)
+				return el
+			case Image:
+				el.AltText = fn(el.AltText)
+				return el
+			case Media:
+				el.AltText = fn(el.AltText)
+				return el
+			case ChoiceSetInput:
+				el.InputFields = mapInput(el.InputFields)
+				el.Placeholder = fn(el.Placeholder)
+				el.Choices = slices.Clone(el.Choices)
+				for i := range el.Choices {
+					el.Choices[i].Title = fn(el.Choices[i].Title)
+				}
+				return el
+			case DateInput:
+				el.InputFields = mapInput(el.InputFields)
+				el.Placeholder = fn(el.Placeholder)
+				return el
+			case TimeInput:
+				el.InputFields = mapInput(el.InputFields)
+				el.Placeholder = fn(el.Placeholder)
+				return el
+			case NumberInput:
+				el.InputFields = mapInput(el.InputFields)
+				el.Placeholder = fn(el.Placeholder)
+				return el
+			case ActionSet:
+				el.Actions = mapActions(el.Actions, mapTitle)
+				return el
+			}
+			return el
+		})
+		c.Actions = mapActions(c.Actions, mapTitle)
+		c.FallbackText = fn(c.FallbackText)
+		c.Speak = fn(c.Speak)
+		return nil
+	}
+	return m
+}
+
+// MapURLs returns middleware that passes the target of every Action.OpenUrl —
+// card actions, selectActions and inline links alike, including those in
+// fallback elements and Action.ShowCard cards — through fn, e.g. to route
+// clicks through a tracker.
+func MapURLs(fn func(string) string) Middleware {
+	var m Middleware
+	mapAction := func(a Action) Action {
+		if a.Type == "Action.OpenUrl" {
+			a.Url = fn(a.Url)
+		}
+		return mapShowCard(a, m)
+	}
+	m = func(c *AdaptiveCard) error {
+		c.Body = transformAll(c.Body, func(el Element) Element {
+			if as, ok := el.(ActionSet); ok {
+				as.Actions = mapActions(as.Actions, mapAction)
+				return as
+			}
+			return mapSelectActions(el, mapAction)
+		})
+		c.Actions = mapActions(c.Actions, mapAction)
+		c.SelectAction = mapActionPtr(c.SelectAction, mapAction)
+		return nil
+	}
+	return m
+}
+
+// mapShowCard applies m to a copy of the card revealed by an Action.ShowCard.
+// m must not fail; MapText and MapURLs never do.
+func mapShowCard(a Action, m Middleware) Action {
+	if a.Card == nil {
+		return a
+	}
+	card := *a.Card
+	_ = m(&card)
+	a.Card = &card
+	return a
+}
+
+// transformAll is transform that also maps fallback elements, so middleware
+// reaches what hosts show in place of elements they cannot render.
+func transformAll(elements []Element, fn func(Element) Element) []Element {
+	var mapEl func(Element) Element
+	mapEl = func(el Element) Element {
+		el = fn(el)
+		b, ok := baseOf(el)
+		if !ok || b.Fallback == nil || b.Fallback.Element == nil {
+			return el
+		}
+		fallback := *b.Fallback
+		fallback.Element = transform([]Element{fallback.Element}, mapEl)[0]
+		b.Fallback = &fallback
+		return withBase(el, b)
+	}
+	return transform(elements, mapEl)
+}
+
+// mapSelectActions returns a copy of el with fn applied to the selectActions
+// that selectActions reports for it.
+func mapSelectActions(el Element, fn func(Action) Action) Element {
+	switch el := el.(type) {
+	case RichTextBlock:
+		el.Inlines = slices.Clone(el.Inlines)
+		for i := range el.Inlines {
+			el.Inlines[i].SelectAction = mapActionPtr(el.Inlines[i].SelectAction, fn)
+		}
+		return el
+	case Icon:
+		el.SelectAction = mapActionPtr(el.SelectAction, fn)
+		return el
+	case Image:
+		el.SelectAction = mapActionPtr(el.SelectAction, fn)
+		return el
+	case Container:
+		el.SelectAction = mapActionPtr(el.SelectAction, fn)
+		return el
+	case ColumnSet:
+		el.SelectAction = mapActionPtr(el.SelectAction, fn)
+		el.Columns = slices.Clone(el.Columns)
+		for i := range el.Columns {
+			el.Columns[i].SelectAction = mapActionPtr(el.Columns[i].SelectAction, fn)
+		}
+		return el
+	}
+	return el
+}
+
+func mapActionPtr(a *Action, fn func(Action) Action) *Action {
+	if a == nil {
+		return nil
+	}
+	mapped := fn(*a)
+	return &mapped
+}
+
+// withoutMiddleware returns a copy of c, and of the cards its Action.ShowCard
+// actions reveal, with no middleware registered, so validation sees the card
+// as built rather than as a Use callback rewrites it.
+func (c AdaptiveCard) withoutMiddleware() AdaptiveCard {
+	strip := func(a Action) Action {
+		if a.Card == nil {
+			return a
+		}
+		card := a.Card.withoutMiddleware()
+		a.Card = &card
+		return a
+	}
+	c.middleware = nil
+	c.Body = transformAll(c.Body, func(el Element) Element {
+		if as, ok := el.(ActionSet); ok {
+			as.Actions = mapActions(as.Actions, strip)
+			return as
+		}
+		return el
+	})
+	c.Actions = mapActions(c.Actions, strip)
+	return c
+}
@@ -0,0 +1,94 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapTextReachesEveryString(t *testing.T) {
+	primary := NewTable()
+	primary.WithFallback(NewTextBlock("fallback SECRET"))
+
+	img := NewImage("https://example.com/a.png")
+	img.WithAltText("alt SECRET")
+	media := NewMedia(MediaSource{MimeType: "video/mp4", URL: "https://example.com/a.mp4"})
+	media.WithAltText("media SECRET")
+	choices := NewChoiceSetInput("pick", Choice{Title: "choice SECRET", Value: "v"})
+	choices.WithLabel("label SECRET")
+	choices.WithPlaceholder("placeholder SECRET")
+	date := NewDateInput("when")
+	date.WithRequired("error SECRET")
+
+	card := newTestCard(primary, img, media, choices, date)
+	card.AddAction(NewShowCardAction("show SECRET", newTestCard(NewTextBlock("nested SECRET"))))
+	card.WithFallbackText("fallbackText SECRET")
+	card.WithSpeak("speak SECRET")
+	card.Use(MapText(func(s string) string { return strings.ReplaceAll(s, "SECRET", "***") }))
+
+	if got := mustMarshal(t, card); strings.Contains(got, "SECRET") {
+		t.Errorf("unredacted text left in %s", got)
+	}
+}
+
+func TestMapURLsReachesNestedActions(t *testing.T) {
+	primary := NewTable()
+	primary.WithFallback(NewActionSet(NewOpenUrlAction("fallback", "https://example.com/fallback")))
+
+	card := newTestCard(primary)
+	card.AddAction(NewShowCardAction("more", newTestCard(
+		NewActionSet(NewOpenUrlAction("nested", "https://example.com/nested")),
+	)))
+	card.WithSelectAction(NewOpenUrlAction("card", "https://example.com/card"))
+	card.Use(MapURLs(func(u string) string { return "https://track.example/?u=" + u }))
+
+	got := mustMarshal(t, card)
+	for _, u := range []string{"fallback", "nested", "card"} {
+		if !strings.Contains(got, "https://track.example/?u=https://example.com/"+u) {
+			t.Errorf("%s URL was not mapped: %s", u, got)
+		}
+	}
+}
+
+func TestMiddlewareDoesNotModifyOriginal(t *testing.T) {
+	inner := newTestCard(NewTextBlock("nested"))
+	card := newTestCard(NewTextBlock("top"))
+	card.AddAction(NewShowCardAction("show", inner))
+	card.Use(MapText(strings.ToUpper))
+
+	_ = mustMarshal(t, card)
+	if tb := card.Body[0].(TextBlock); tb.Text != "top" {
+		t.Errorf("body text changed to %q", tb.Text)
+	}
+	if tb := card.Actions[0].flat().Card.Body[0].(TextBlock); tb.Text != "nested" {
+		t.Errorf("ShowCard text changed to %q", tb.Text)
+	}
+}
+
+func TestValidateDoesNotRunMiddleware(t *testing.T) {
+	calls := 0
+	addTable := func(c *AdaptiveCard) error {
+		calls++
+		c.Body = append(c.Body, NewTable())
+		return nil
+	}
+	nested := newTestCard(NewTextBlock("nested"))
+	nested.Use(addTable)
+
+	card := newTestCard(NewTextBlock("hi"))
+	card.Version = "1.2"
+	card.AddAction(NewShowCardAction("more", nested))
+	card.Use(addTable)
+
+	if errs := card.Validate(); len(errs) != 0 {
+		t.Errorf("Validate() = %v, want no errors", errs)
+	}
+	if errs, err := card.ValidateSchema(); err != nil || len(errs) != 0 {
+		t.Errorf("ValidateSchema() = %v, %v, want no errors", errs, err)
+	}
+	if calls != 0 {
+		t.Errorf("middleware ran %d times during validation", calls)
+	}
+	if got := mustMarshal(t, card); strings.Count(got, `"Table"`) != 2 {
+		t.Errorf("middleware did not run on marshal: %s", got)
+	}
+}
@@ -0,0 +1,105 @@
+package adaptivecard
+
+// Constructors such as NewTextBlock, NewImage, NewIcon and NewProgressBar
+// accept options, so an element can be configured where it is created:
+//
+//	title := adaptivecard.NewTextBlock("Deploy failed",
+//		adaptivecard.WithWeight(adaptivecard.WeightBolder),
+//		adaptivecard.WithColor(adaptivecard.ColorAttention),
+//		adaptivecard.WithSpacing(adaptivecard.SpacingMedium),
+//	)
+//
+// Options apply in order after the constructor's defaults, so they can
+// override them (e.g. WithWrap(false)).
+
+// TextBlockOption configures a TextBlock in NewTextBlock.
+type TextBlockOption interface {
+	applyTextBlock(*TextBlock)
+}
+
+// ImageOption configures an Image in NewImage.
+type ImageOption interface {
+	applyImage(*Image)
+}
+
+// IconOption configures an Icon in NewIcon.
+type IconOption interface {
+	applyIcon(*Icon)
+}
+
+// ProgressBarOption configures a ProgressBar in NewProgressBar.
+type ProgressBarOption interface {
+	applyProgressBar(*ProgressBar)
+}
+
+// ElementOption sets a property every element has; it can be passed to any
+// element constructor that takes options.
+type ElementOption func(*BaseElement)
+
+func (o ElementOption) applyTextBlock(t *TextBlock)     { o(&t.BaseElement) }
+func (o ElementOption) applyImage(i *Image)             { o(&i.BaseElement) }
+func (o ElementOption) applyIcon(i *Icon)               { o(&i.BaseElement) }
+func (o ElementOption) applyProgressBar(p *ProgressBar) { o(&p.BaseElement) }
+
+func WithID(id string) ElementOption {
+	return func(b *BaseElement) { b.WithID(id) }
+}
+
+func WithSpacing(spacing Spacing) ElementOption {
+	return func(b *BaseElement) { b.WithSpacing(spacing) }
+}
+
+func WithSeparator() ElementOption {
+	return func(b *BaseElement) { b.WithSeparator() }
+}
+
+func WithHeight(height string) ElementOption {
+	return func(b *BaseElement) { b.WithHeight(height) }
+}
+
+func WithVisible(visible bool) ElementOption {
+	return func(b *BaseElement) { b.WithVisible(visible) }
+}
+
+// ColorOption sets the color of a TextBlock, Icon or ProgressBar.
+type ColorOption Color
+
+func (o ColorOption) applyTextBlock(t *TextBlock)     { t.WithColor(Color(o)) }
+func (o ColorOption) applyIcon(i *Icon)               { i.WithColor(Color(o)) }
+func (o ColorOption) applyProgressBar(p *ProgressBar) { p.WithColor(Color(o)) }
+
+func WithColor(color Color) ColorOption {
+	return ColorOption(color)
+}
+
+type textBlockOption func(*TextBlock)
+
+func (o textBlockOption) applyTextBlock(t *TextBlock) { o(t) }
+
+func WithWeight(weight Weight) TextBlockOption {
+	return textBlockOption(func(t *TextBlock) { t.WithWeight(weight) })
+}
+
+func WithSize(size Size) TextBlockOption {
+	return textBlockOption(func(t *TextBlock) { t.WithSize(size) })
+}
+
+func WithSubtle() TextBlockOption {
+	return textBlockOption(func(t *TextBlock) { t.WithSubtle() })
+}
+
+func WithWrap(wrap bool) TextBlockOption {
+	return textBlockOption(func(t *TextBlock) { t.WithWrap(wrap) })
+}
+
+func WithMaxLines(n int) TextBlockOption {
+	return textBlockOption(func(t *TextBlock) { t.WithMaxLines(n) })
+}
+
+type imageOption func(*Image)
+
+func (o imageOption) applyImage(i *Image) { o(i) }
+
+func WithAltText(altText string) ImageOption {
+	return imageOption(func(i *Image) { i.WithAltText(altText) })
+}
@@ -0,0 +1,491 @@
+package adaptivecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	elementTypesMu sync.RWMutex
+	// customTypes maps the Go types registered with RegisterElementType to
+	// their "type" discriminator.
+	customTypes = map[reflect.Type]string{}
+)
+
+// elementTypes maps each "type" discriminator to a zero value of the
+// element decoded for it.
+var elementTypes = map[string]func() Element{
+	"TextBlock":       func() Element { return TextBlock{} },
+	"Container":       func() Element { return Container{} },
+	"ColumnSet":       func() Element { return ColumnSet{} },
+	"FactSet":         func() Element { return FactSet{} },
+	"Table":           func() Element { return Table{} },
+	"Image":           func() Element { return Image{} },
+	"Media":           func() Element { return Media{} },
+	"RichTextBlock":   func() Element { return RichTextBlock{} },
+	"Icon":            func() Element { return Icon{} },
+	"Badge":           func() Element { return Badge{} },
+	"ProgressBar":     func() Element { return ProgressBar{} },
+	"ActionSet":       func() Element { return ActionSet{} },
+	"Input.ChoiceSet": func() Element { return ChoiceSetInput{} },
+	"Input.Date":      func() Element { return DateInput{} },
+	"Input.Time":      func() Element { return TimeInput{} },
+	"Input.Number":    func() Element { return NumberInput{} },
+}
+
+// CustomElement lets types outside the package implement Element. Embed it
+// in a struct that has a `json:"type"` field (and BaseElement for the common
+// properties) and register the type with RegisterElementType. Custom
+// elements are serialized with encoding/json, so they may implement
+// json.Marshaler.
+type CustomElement struct{}
+
+func (CustomElement) isElement() {}
+func (CustomElement) toRaw() any { return nil }
+func (CustomElement) custom()    {}
+
+// RegisterElementType makes cards decode elements of typeName into the type
+// returned by factory, and reports typeName for them in paths, features and
+// findings. It panics if typeName is empty or already registered, like
+// sql.Register; call it from an init function.
+func RegisterElementType(typeName string, factory func() Element) {
+	if typeName == "" || factory == nil {
+		panic("adaptivecard: RegisterElementType needs a type name and a factory")
+	}
+	elementTypesMu.Lock()
+	defer elementTypesMu.Unlock()
+	if _, dup := elementTypes[typeName]; dup {
+		panic("adaptivecard: element type " + typeName + " is already registered")
+	}
+	elementTypes[typeName] = factory
+	customTypes[reflect.TypeOf(factory())] = typeName
+}
+
+// rawOf returns the value to serialize for el.
+func rawOf(el Element) any {
+	var v any
+	if _, ok := el.(interface{ custom() }); ok {
+		v = el
+	} else {
+		v = el.toRaw()
+	}
+	if b, ok := baseOf(el); ok {
+		return extraOf(v, b.Extra)
+	}
+	return v
+}
+
+// extraOf returns v, followed by extra when it has any properties.
+func extraOf(v any, extra map[string]json.RawMessage) any {
+	if len(extra) == 0 {
+		return v
+	}
+	return withExtra{v, extra}
+}
+
+// withExtra serializes v followed by the extra properties it does not set
+// itself.
+type withExtra struct {
+	v     any
+	extra map[string]json.RawMessage
+}
+
+func (w withExtra) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(w.v)
+	if err != nil {
+		return nil, err
+	}
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(data, &known); err != nil {
+		return data, nil
+	}
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, key := range slices.Sorted(maps.Keys(w.extra)) {
+		if _, ok := known[key]; ok {
+			continue
+		}
+		name, _ := json.Marshal(key)
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(w.extra[key])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unknownKeys returns the properties of the JSON object data that have no
+// field in t.
+func unknownKeys(data json.RawMessage, t reflect.Type) map[string]json.RawMessage {
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(data, &obj) != nil {
+		return nil
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" && !field.Anonymous {
+			name = field.Name
+		}
+		delete(obj, name)
+	}
+	if len(obj) == 0 {
+		return nil
+	}
+	return obj
+}
+
+// Default limits applied by ParseCard and AdaptiveCard.UnmarshalJSON.
+const (
+	// DefaultMaxParseDepth is the deepest JSON nesting accepted, which
+	// leaves room for about 30 levels of nested containers.
+	DefaultMaxParseDepth = 128
+	// DefaultMaxParseSize is the largest card accepted, in bytes; Teams
+	// itself rejects messages far smaller.
+	DefaultMaxParseSize = 1 << 20
+)
+
+// ErrParseLimit is returned, wrapped, for input that exceeds the depth or
+// size limits of ParseCard.
+var ErrParseLimit = errors.New("adaptivecard: parse limit exceeded")
+
+type parseOptions struct {
+	maxDepth int
+	maxSize  int
+}
+
+// ParseOption configures ParseCard.
+type ParseOption func(*parseOptions)
+
+// MaxParseDepth sets the deepest JSON nesting ParseCard accepts. Zero or less
+// means no limit.
+func MaxParseDepth(n int) ParseOption {
+	return func(o *parseOptions) { o.maxDepth = n }
+}
+
+// MaxParseSize sets the largest input ParseCard accepts, in bytes. Zero or
+// less means no limit.
+func MaxParseSize(n int) ParseOption {
+	return func(o *parseOptions) { o.maxSize = n }
+}
+
+// ParseCard decodes card JSON; see AdaptiveCard.UnmarshalJSON. Input deeper
+// than DefaultMaxParseDepth or larger than DefaultMaxParseSize is rejected
+// with ErrParseLimit before decoding; MaxParseDepth and MaxParseSize change
+// the limits.
+func ParseCard(data []byte, opts ...ParseOption) (AdaptiveCard, error) {
+	o := parseOptions{maxDepth: DefaultMaxParseDepth, maxSize: DefaultMaxParseSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := o.check(data); err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: parsing card: %w", err)
+	}
+	var c AdaptiveCard
+	if err := c.decode(data); err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: parsing card: %w", err)
+	}
+	return c, nil
+}
+
+// check rejects data that exceeds the limits. It scans the bytes once, so
+// hostile input is refused before the recursive decoder sees it.
+func (o parseOptions) check(data []byte) error {
+	if o.maxSize > 0 && len(data) > o.maxSize {
+		return fmt.Errorf("%w: input is %d bytes, limit is %d", ErrParseLimit, len(data), o.maxSize)
+	}
+	if o.maxDepth <= 0 {
+		return nil
+	}
+	depth := 0
+	inString, escaped := false, false
+	for _, b := range data {
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			switch b {
+			case '\\':
+				escaped = true
+			case '"':
+				inString = false
+			}
+		case b == '"':
+			inString = true
+		case b == '{' || b == '[':
+			depth++
+			if depth > o.maxDepth {
+				return fmt.Errorf("%w: nesting deeper than %d", ErrParseLimit, o.maxDepth)
+			}
+		case b == '}' || b == ']':
+			depth--
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes card JSON, e.g. from the Adaptive Cards Designer,
+// into the package's element and action types, recursively, so the card can
+// be modified and re-emitted. Elements of unknown types are kept as
+// RawElement; actions of unknown types decode into the flat Action. The
+// default ParseCard limits apply.
+func (c *AdaptiveCard) UnmarshalJSON(data []byte) error {
+	o := parseOptions{maxDepth: DefaultMaxParseDepth, maxSize: DefaultMaxParseSize}
+	if err := o.check(data); err != nil {
+		return err
+	}
+	return c.decode(data)
+}
+
+// decode is UnmarshalJSON without the limits, which only need checking once
+// for the outermost card.
+func (c *AdaptiveCard) decode(data []byte) error {
+	var raw struct {
+		Type    string            `json:"type"`
+		Version string            `json:"version"`
+		Body    []json.RawMessage `json:"body"`
+		Schema  string            `json:"$schema"`
+		Actions []json.RawMessage `json:"actions"`
+		MSTeams *MSTeamsInfo      `json:"msteams"`
+		Refresh *Refresh          `json:"refresh"`
+
+		Authentication *Authentication `json:"authentication"`
+
+		SelectAction *Action `json:"selectAction"`
+		FallbackText string  `json:"fallbackText"`
+		Speak        string  `json:"speak"`
+		Lang         string  `json:"lang"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	body, err := decodeElements(raw.Body)
+	if err != nil {
+		return err
+	}
+	actions, err := decodeActions(raw.Actions)
+	if err != nil {
+		return err
+	}
+	*c = AdaptiveCard{
+		Type:    raw.Type,
+		Version: raw.Version,
+		Body:    body,
+		Schema:  raw.Schema,
+		Actions: actions,
+		MSTeams: raw.MSTeams,
+		Refresh: raw.Refresh,
+
+		Authentication: raw.Authentication,
+
+		SelectAction: raw.SelectAction,
+		FallbackText: raw.FallbackText,
+		Speak:        raw.Speak,
+		Lang:         raw.Lang,
+		Extra:        unknownKeys(data, reflect.TypeOf(cardJSON{})),
+	}
+	return nil
+}
+
+func decodeElements(items []json.RawMessage) ([]Element, error) {
+	if items == nil {
+		return nil, nil
+	}
+	out := make([]Element, len(items))
+	for i, item := range items {
+		el, err := decodeElement(item)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = el
+	}
+	return out, nil
+}
+
+func decodeElement(data json.RawMessage) (Element, error) {
+	typ, err := rawType(data)
+	if err != nil {
+		return nil, fmt.Errorf("element: %w", err)
+	}
+	elementTypesMu.RLock()
+	newElement, ok := elementTypes[typ]
+	elementTypesMu.RUnlock()
+	if !ok {
+		return RawElement{Type: typ, JSON: data}, nil
+	}
+	ptr := reflect.New(reflect.TypeOf(newElement()))
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return nil, fmt.Errorf("%s: %w", typ, err)
+	}
+	el := ptr.Elem().Interface().(Element)
+	if b, ok := baseOf(el); ok {
+		if extra := unknownKeys(data, ptr.Type().Elem()); extra != nil {
+			b.Extra = extra
+			el = withBase(el, b)
+		}
+	}
+	return el, nil
+}
+
+func decodeActions(items []json.RawMessage) ([]ActionElement, error) {
+	if items == nil {
+		return nil, nil
+	}
+	out := make([]ActionElement, len(items))
+	for i, item := range items {
+		var a Action
+		if err := json.Unmarshal(item, &a); err != nil {
+			return nil, fmt.Errorf("action: %w", err)
+		}
+		out[i] = typedAction(a)
+	}
+	return out, nil
+}
+
+// UnmarshalJSON keeps unrecognized properties in Extra and decodes the card
+// of an Action.ShowCard without checking the parse limits again; they were
+// checked for the outermost card.
+func (a *Action) UnmarshalJSON(data []byte) error {
+	type plain Action
+	aux := struct {
+		*plain
+		Card json.RawMessage `json:"card"`
+	}{plain: (*plain)(a)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	a.Extra = unknownKeys(data, reflect.TypeOf(Action{}))
+	if len(aux.Card) == 0 || string(aux.Card) == "null" {
+		return nil
+	}
+	a.Card = &AdaptiveCard{}
+	return a.Card.decode(aux.Card)
+}
+
+func (c *Container) UnmarshalJSON(data []byte) error {
+	type plain Container
+	aux := struct {
+		*plain
+		Items []json.RawMessage `json:"items"`
+	}{plain: (*plain)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	items, err := decodeElements(aux.Items)
+	c.Items = items
+	return err
+}
+
+func (col *Column) UnmarshalJSON(data []byte) error {
+	type plain Column
+	aux := struct {
+		*plain
+		Items []json.RawMessage `json:"items"`
+	}{plain: (*plain)(col)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	items, err := decodeElements(aux.Items)
+	col.Items = items
+	col.Extra = unknownKeys(data, reflect.TypeOf(Column{}))
+	return err
+}
+
+func (tc *TableCell) UnmarshalJSON(data []byte) error {
+	type plain TableCell
+	aux := struct {
+		*plain
+		Items []json.RawMessage `json:"items"`
+	}{plain: (*plain)(tc)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	items, err := decodeElements(aux.Items)
+	tc.Items = items
+	tc.Extra = unknownKeys(data, reflect.TypeOf(TableCell{}))
+	return err
+}
+
+func (tr *TableRow) UnmarshalJSON(data []byte) error {
+	type plain TableRow
+	if err := json.Unmarshal(data, (*plain)(tr)); err != nil {
+		return err
+	}
+	tr.Extra = unknownKeys(data, reflect.TypeOf(TableRow{}))
+	return nil
+}
+
+func (as *ActionSet) UnmarshalJSON(data []byte) error {
+	type plain ActionSet
+	aux := struct {
+		*plain
+		Actions []json.RawMessage `json:"actions"`
+	}{plain: (*plain)(as)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	actions, err := decodeActions(aux.Actions)
+	as.Actions = actions
+	return err
+}
+
+func (f *Fallback) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		*f = Fallback{Drop: s == "drop"}
+		return nil
+	}
+	el, err := decodeElement(data)
+	if err != nil {
+		return err
+	}
+	*f = Fallback{Element: el}
+	return nil
+}
+
+// UnmarshalJSON also accepts the bare URL form of a background image.
+func (b *BackgroundImage) UnmarshalJSON(data []byte) error {
+	var url string
+	if json.Unmarshal(data, &url) == nil {
+		*b = NewBackgroundImage(url)
+		return nil
+	}
+	type plain BackgroundImage
+	return json.Unmarshal(data, (*plain)(b))
+}
+
+// UnmarshalJSON also accepts the bare element ID form of a target.
+func (t *TargetElement) UnmarshalJSON(data []byte) error {
+	var id string
+	if json.Unmarshal(data, &id) == nil {
+		*t = TargetElement{ElementID: id}
+		return nil
+	}
+	type plain TargetElement
+	return json.Unmarshal(data, (*plain)(t))
+}
+
+// UnmarshalJSON also accepts the plain string form of an inline.
+func (tr *TextRun) UnmarshalJSON(data []byte) error {
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		*tr = NewTextRun(text)
+		return nil
+	}
+	type plain TextRun
+	return json.Unmarshal(data, (*plain)(tr))
+}
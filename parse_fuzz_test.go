@@ -0,0 +1,84 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func FuzzParseCard(f *testing.F) {
+	for _, seed := range []string{
+		`{"type":"AdaptiveCard","version":"1.5","body":[]}`,
+		`{"type":"AdaptiveCard","version":"1.5","body":[{"type":"TextBlock","text":"hi","wrap":true}]}`,
+		`{"type":"AdaptiveCard","body":[{"type":"Container","items":[{"type":"ColumnSet","columns":[{"type":"Column","items":[{"type":"Image","url":"https://example.com/a.png"}]}]}]}]}`,
+		`{"type":"AdaptiveCard","body":[{"type":"Table","columns":[{"width":1}],"rows":[{"type":"TableRow","cells":[{"type":"TableCell","items":[]}]}]}]}`,
+		`{"type":"AdaptiveCard","body":[{"type":"Unknown","x":1,"fallback":"drop"}],"actions":[{"type":"Action.ShowCard","title":"t","card":{"type":"AdaptiveCard","body":[]}}]}`,
+		`{"type":"AdaptiveCard","body":[{"type":"TextBlock","text":"x","fallback":{"type":"TextBlock","text":"y"}}]}`,
+		`[`, `null`, `{"body":{}}`,
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		card, err := ParseCard(data)
+		if err != nil {
+			return
+		}
+		out, err := json.Marshal(card)
+		if err != nil {
+			t.Fatalf("parsed card does not marshal: %v", err)
+		}
+		if _, err := ParseCard(out); err != nil && !errors.Is(err, ErrParseLimit) {
+			t.Fatalf("marshaled card does not parse: %v\n%s", err, out)
+		}
+	})
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	f.Add([]byte(`{"type":"AdaptiveCard","body":[{"type":"Container","items":[]}]}`))
+	f.Add([]byte(`{"type":"AdaptiveCard","actions":[{"type":"Action.ShowCard","card":{"body":[{"type":"Badge"}]}}]}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var c AdaptiveCard
+		_ = json.Unmarshal(data, &c)
+	})
+}
+
+func TestParseCardLimits(t *testing.T) {
+	nested := func(levels int) string {
+		return `{"type":"AdaptiveCard","body":[` +
+			strings.Repeat(`{"type":"Container","items":[`, levels) +
+			strings.Repeat(`]}`, levels) + `]}`
+	}
+	tests := []struct {
+		name    string
+		data    string
+		opts    []ParseOption
+		wantErr bool
+	}{
+		{"shallow", nested(10), nil, false},
+		{"too deep", nested(100), nil, true},
+		{"raised depth", nested(100), []ParseOption{MaxParseDepth(300)}, false},
+		{"no depth limit", nested(100), []ParseOption{MaxParseDepth(0)}, false},
+		{"too large", nested(1), []ParseOption{MaxParseSize(10)}, true},
+		{"brackets in strings", `{"type":"AdaptiveCard","body":[{"type":"TextBlock","text":"` + strings.Repeat("[{", 200) + `\"]"}]}`, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCard([]byte(tt.data), tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrParseLimit) {
+				t.Errorf("err = %v, want ErrParseLimit", err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONRejectsDeepInput(t *testing.T) {
+	data := `{"type":"AdaptiveCard","body":[` + strings.Repeat(`{"type":"Container","items":[`, 200) + strings.Repeat(`]}`, 200) + `]}`
+	var c AdaptiveCard
+	if err := json.Unmarshal([]byte(data), &c); !errors.Is(err, ErrParseLimit) {
+		t.Errorf("err = %v, want ErrParseLimit", err)
+	}
+}
@@ -0,0 +1,82 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ----------------------
+// ProgressBar
+// ----------------------
+type ProgressBar struct {
+	Type string `json:"type"`
+	BaseElement
+	Value float64 `json:"value"`
+	Max   float64 `json:"max,omitempty"`
+	Color Color   `json:"color,omitempty"`
+}
+
+// NewProgressBar returns a bar filled to value out of max (100 when max is 0).
+func NewProgressBar(value, max float64, opts ...ProgressBarOption) ProgressBar {
+	p := ProgressBar{
+		Type:  "ProgressBar",
+		Value: value,
+		Max:   max,
+	}
+	for _, opt := range opts {
+		opt.applyProgressBar(&p)
+	}
+	return p
+}
+func (ProgressBar) isElement() {}
+func (p ProgressBar) toRaw() any {
+	return p
+}
+
+// WithColor sets "accent", "good", "warning" or "attention".
+func (p *ProgressBar) WithColor(color Color) {
+	p.Color = color
+}
+
+func (p ProgressBar) ratio() float64 {
+	limit := p.Max
+	if limit <= 0 {
+		limit = 100
+	}
+	return math.Min(math.Max(p.Value/limit, 0), 1)
+}
+
+// progressBarWidth is the number of block characters in an emulated bar.
+const progressBarWidth = 10
+
+// ProgressText renders ratio (0–1) as a unicode block bar with a percentage,
+// e.g. "▓▓▓▓░░░░░░ 40%".
+func ProgressText(ratio float64) string {
+	ratio = math.Min(math.Max(ratio, 0), 1)
+	filled := int(math.Round(ratio * progressBarWidth))
+	return fmt.Sprintf("%s%s %d%%",
+		strings.Repeat("▓", filled), strings.Repeat("░", progressBarWidth-filled), int(math.Round(ratio*100)))
+}
+
+// EmulateProgressBars returns middleware that, when the card's version
+// predates ProgressBar, replaces every ProgressBar with a TextBlock showing
+// ProgressText, so one builder serves both modern Teams and older hosts.
+func EmulateProgressBars() Middleware {
+	return func(c *AdaptiveCard) error {
+		if supportsType(c.Version, "ProgressBar") {
+			return nil
+		}
+		c.Body = transform(c.Body, func(el Element) Element {
+			p, ok := el.(ProgressBar)
+			if !ok {
+				return el
+			}
+			tb := NewTextBlock(ProgressText(p.ratio()))
+			tb.WithID(p.ID)
+			tb.WithColor(p.Color)
+			return tb
+		})
+		return nil
+	}
+}
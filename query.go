@@ -0,0 +1,300 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// Query evaluates a JSONPath expression against the card's JSON and returns
+// the matches in document order, as the values encoding/json would decode
+// them into (map[string]any, []any, string, float64, bool or nil).
+//
+// The supported subset is:
+//
+//	$                 the card
+//	.name ['name']    object member
+//	[2] [-1]          array element, negative counts from the end
+//	.* [*]            every member or element
+//	..name ..*        recursive descent
+//	[?(@.a.b)]        elements with a member at the relative path
+//	[?(@.type=='X')]  elements whose member equals (==) or differs from (!=)
+//	                  a string, number, true, false or null
+//
+// For example, "$.body[?(@.type=='TextBlock')].text" returns the text of
+// every top-level TextBlock.
+func (c AdaptiveCard) Query(expr string) ([]any, error) {
+	steps, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	root, err := orderedjson.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []any{root}
+	for _, s := range steps {
+		nodes = s.apply(nodes)
+	}
+	out := make([]any, len(nodes))
+	for i, n := range nodes {
+		out[i] = plainJSON(n)
+	}
+	return out, nil
+}
+
+type stepKind int
+
+const (
+	stepMember stepKind = iota
+	stepIndex
+	stepWildcard
+	stepDescend
+	stepFilter
+)
+
+type queryStep struct {
+	kind   stepKind
+	name   string
+	index  int
+	filter *queryFilter
+}
+
+type queryFilter struct {
+	path  []queryStep
+	op    string // "", "==" or "!="
+	value any
+}
+
+func parseQuery(expr string) ([]queryStep, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("adaptivecard: query %q must start with $", expr)
+	}
+	var steps []queryStep
+	rest := expr[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			steps = append(steps, queryStep{kind: stepDescend})
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				continue
+			}
+			var s queryStep
+			s, rest = parseDotted(rest)
+			steps = append(steps, s)
+		case rest[0] == '.':
+			var s queryStep
+			s, rest = parseDotted(rest[1:])
+			steps = append(steps, s)
+		case rest[0] == '[':
+			end := closingBracket(rest)
+			if end < 0 {
+				return nil, fmt.Errorf("adaptivecard: query %q: unterminated [", expr)
+			}
+			s, err := parseBracket(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("adaptivecard: query %q: %w", expr, err)
+			}
+			steps = append(steps, s)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("adaptivecard: query %q: unexpected %q", expr, rest)
+		}
+		if len(steps) > 0 {
+			if s := steps[len(steps)-1]; s.kind == stepMember && s.name == "" {
+				return nil, fmt.Errorf("adaptivecard: query %q: empty member name", expr)
+			}
+		}
+	}
+	return steps, nil
+}
+
+// parseDotted parses the name following a dot, up to the next . or [.
+func parseDotted(s string) (queryStep, string) {
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		end = len(s)
+	}
+	if s[:end] == "*" {
+		return queryStep{kind: stepWildcard}, s[end:]
+	}
+	return queryStep{kind: stepMember, name: s[:end]}, s[end:]
+}
+
+// closingBracket returns the index of the ] closing the [ at s[0], skipping
+// quoted strings.
+func closingBracket(s string) int {
+	var quote byte
+	for i := 1; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '\'' || s[i] == '"':
+			quote = s[i]
+		case s[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseBracket(s string) (queryStep, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "*":
+		return queryStep{kind: stepWildcard}, nil
+	case strings.HasPrefix(s, "?(") && strings.HasSuffix(s, ")"):
+		f, err := parseFilter(strings.TrimSpace(s[2 : len(s)-1]))
+		return queryStep{kind: stepFilter, filter: f}, err
+	case len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0]:
+		return queryStep{kind: stepMember, name: s[1 : len(s)-1]}, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return queryStep{}, fmt.Errorf("invalid selector [%s]", s)
+	}
+	return queryStep{kind: stepIndex, index: n}, nil
+}
+
+func parseFilter(s string) (*queryFilter, error) {
+	if !strings.HasPrefix(s, "@") {
+		return nil, fmt.Errorf("filter %q must start with @", s)
+	}
+	f := &queryFilter{}
+	left := s[1:]
+	for _, op := range []string{"==", "!="} {
+		if i := strings.Index(left, op); i >= 0 {
+			literal := strings.TrimSpace(left[i+2:])
+			left, f.op = strings.TrimSpace(left[:i]), op
+			v, err := parseLiteral(literal)
+			if err != nil {
+				return nil, fmt.Errorf("filter %q: %w", s, err)
+			}
+			f.value = v
+			break
+		}
+	}
+	path, err := parseQuery("$" + left)
+	if err != nil {
+		return nil, err
+	}
+	f.path = path
+	return f, nil
+}
+
+func parseLiteral(s string) (any, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return s[1 : len(s)-1], nil
+	}
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, fmt.Errorf("invalid literal %s", s)
+	}
+	return v, nil
+}
+
+func (s queryStep) apply(nodes []any) []any {
+	var out []any
+	for _, n := range nodes {
+		switch s.kind {
+		case stepMember:
+			if obj, ok := n.(*orderedjson.Object); ok {
+				if v, ok := obj.Get(s.name); ok {
+					out = append(out, v)
+				}
+			}
+		case stepIndex:
+			if arr, ok := n.([]any); ok {
+				i := s.index
+				if i < 0 {
+					i += len(arr)
+				}
+				if i >= 0 && i < len(arr) {
+					out = append(out, arr[i])
+				}
+			}
+		case stepWildcard:
+			out = append(out, jsonChildren(n)...)
+		case stepDescend:
+			out = appendDescendants(out, n)
+		case stepFilter:
+			for _, child := range jsonChildren(n) {
+				if s.filter.match(child) {
+					out = append(out, child)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func (f *queryFilter) match(n any) bool {
+	nodes := []any{n}
+	for _, s := range f.path {
+		nodes = s.apply(nodes)
+	}
+	switch f.op {
+	case "==":
+		return len(nodes) > 0 && reflect.DeepEqual(plainJSON(nodes[0]), f.value)
+	case "!=":
+		return len(nodes) == 0 || !reflect.DeepEqual(plainJSON(nodes[0]), f.value)
+	}
+	return len(nodes) > 0
+}
+
+func jsonChildren(n any) []any {
+	switch n := n.(type) {
+	case *orderedjson.Object:
+		out := make([]any, len(n.Members))
+		for i, m := range n.Members {
+			out[i] = m.Value
+		}
+		return out
+	case []any:
+		return n
+	}
+	return nil
+}
+
+// appendDescendants appends n and everything below it, in document order.
+func appendDescendants(out []any, n any) []any {
+	out = append(out, n)
+	for _, child := range jsonChildren(n) {
+		out = appendDescendants(out, child)
+	}
+	return out
+}
+
+// plainJSON converts an orderedjson tree to encoding/json's generic values.
+func plainJSON(v any) any {
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		m := make(map[string]any, len(v.Members))
+		for _, member := range v.Members {
+			m[member.Key] = plainJSON(member.Value)
+		}
+		return m
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = plainJSON(item)
+		}
+		return out
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
@@ -0,0 +1,93 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// RawElement is a pre-serialized body element, e.g. a snippet copied from
+// the Adaptive Cards Designer, emitted as is. Type is read from the JSON.
+type RawElement struct {
+	Type string          `json:"-"`
+	JSON json.RawMessage `json:"-"`
+}
+
+// NewRawElement validates that data is a JSON object with a "type".
+func NewRawElement(data json.RawMessage) (RawElement, error) {
+	typ, err := rawType(data)
+	if err != nil {
+		return RawElement{}, fmt.Errorf("adaptivecard: raw element: %w", err)
+	}
+	return RawElement{Type: typ, JSON: data}, nil
+}
+
+func (RawElement) isElement() {}
+func (r RawElement) toRaw() any {
+	return r.JSON
+}
+
+// AddRawBody appends a pre-serialized element to the body.
+func (c *AdaptiveCard) AddRawBody(data json.RawMessage) error {
+	el, err := NewRawElement(data)
+	if err != nil {
+		return err
+	}
+	c.AddBody(el)
+	return nil
+}
+
+// RawAction is a pre-serialized action, emitted as is in its place among the
+// card's or an ActionSet's actions. Type is read from the JSON.
+type RawAction struct {
+	Type string          `json:"-"`
+	JSON json.RawMessage `json:"-"`
+}
+
+// NewRawAction validates that data is a JSON object with a "type".
+func NewRawAction(data json.RawMessage) (RawAction, error) {
+	typ, err := rawType(data)
+	if err != nil {
+		return RawAction{}, fmt.Errorf("adaptivecard: raw action: %w", err)
+	}
+	return RawAction{Type: typ, JSON: data}, nil
+}
+
+func (RawAction) isAction() {}
+
+// flat decodes the action so validation and middleware see its properties;
+// JSON that does not fit Action yields just the type.
+func (r RawAction) flat() Action {
+	var a Action
+	if err := json.Unmarshal(r.JSON, &a); err != nil {
+		return Action{Type: r.Type}
+	}
+	return a
+}
+
+func (r RawAction) MarshalJSON() ([]byte, error) {
+	return r.JSON, nil
+}
+
+// AddRawAction appends a pre-serialized action after the card's current
+// actions.
+func (c *AdaptiveCard) AddRawAction(data json.RawMessage) error {
+	a, err := NewRawAction(data)
+	if err != nil {
+		return err
+	}
+	c.AddAction(a)
+	return nil
+}
+
+func rawType(data json.RawMessage) (string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", errors.New("not a JSON object")
+	}
+	var typ string
+	if err := json.Unmarshal(obj["type"], &typ); err != nil || typ == "" {
+		return "", errors.New(`missing "type"`)
+	}
+	return typ, nil
+}
@@ -0,0 +1,90 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRawActionKeepsItsPlace(t *testing.T) {
+	raw := `{"url":"https://example.com/raw","type":"Action.OpenUrl","title":"raw"}`
+	rawAction, err := NewRawAction(json.RawMessage(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		card func() AdaptiveCard
+		want string
+	}{
+		{
+			name: "card actions",
+			card: func() AdaptiveCard {
+				c := newTestCard()
+				c.AddAction(NewSubmitAction("first", nil))
+				if err := c.AddRawAction(json.RawMessage(raw)); err != nil {
+					t.Fatal(err)
+				}
+				c.AddAction(NewSubmitAction("last", nil))
+				return c
+			},
+			want: `"actions":[{"type":"Action.Submit","title":"first"},` + raw + `,{"type":"Action.Submit","title":"last"}]`,
+		},
+		{
+			name: "action set",
+			card: func() AdaptiveCard {
+				return newTestCard(NewActionSet(NewSubmitAction("first", nil), rawAction))
+			},
+			want: `"actions":[{"type":"Action.Submit","title":"first"},` + raw + `]`,
+		},
+		{
+			name: "rewritten by middleware",
+			card: func() AdaptiveCard {
+				c := newTestCard()
+				c.AddAction(rawAction)
+				c.Use(MapText(strings.ToUpper))
+				return c
+			},
+			want: `"actions":[{"type":"Action.OpenUrl","title":"RAW","url":"https://example.com/raw"}]`,
+		},
+		{
+			name: "unchanged by middleware",
+			card: func() AdaptiveCard {
+				c := newTestCard()
+				c.AddAction(rawAction)
+				c.Use(MapURLs(func(s string) string { return s }))
+				return c
+			},
+			want: `"actions":[` + raw + `]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustMarshal(t, tt.card()); !strings.Contains(got, tt.want) {
+				t.Errorf("got %s\nwant it to contain %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRawActionIsValidated(t *testing.T) {
+	card := newTestCard()
+	if err := card.AddRawAction(json.RawMessage(`{"type":"Action.OpenUrl","title":"no url"}`)); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range card.Validate() {
+		if e.Path == "$.actions[0].url" {
+			return
+		}
+	}
+	t.Errorf("Validate() = %v, want the missing url reported", card.Validate())
+}
+
+func TestNewRawActionRejectsInvalidJSON(t *testing.T) {
+	for _, data := range []string{`[]`, `{"title":"x"}`, `{"type":""}`} {
+		if _, err := NewRawAction(json.RawMessage(data)); err == nil {
+			t.Errorf("NewRawAction(%s) succeeded", data)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package adaptivecard
+
+import "fmt"
+
+// MaxRefreshUserIDs is the largest number of users Teams will automatically
+// refresh a card for.
+const MaxRefreshUserIDs = 60
+
+// Refresh makes Teams invoke Action when the card is displayed to one of
+// UserIDs, so the bot can answer with a user-specific card (Universal Actions).
+type Refresh struct {
+	Action  Action   `json:"action"`
+	UserIDs []string `json:"userIds,omitempty"`
+}
+
+func NewExecuteAction(title, verb string, data any) ExecuteAction {
+	return ExecuteAction{
+		Type:  "Action.Execute",
+		Title: title,
+		Verb:  verb,
+		Data:  data,
+	}
+}
+
+// EnableRefresh turns the card into a refreshable, user-specific card: Teams
+// sends an Action.Execute with verb and data for each user in userIDs when
+// they view it. At most MaxRefreshUserIDs users can be listed.
+func (c *AdaptiveCard) EnableRefresh(verb string, userIDs []string, data any) error {
+	if verb == "" {
+		return fmt.Errorf("adaptivecard: refresh verb must not be empty")
+	}
+	if len(userIDs) > MaxRefreshUserIDs {
+		return fmt.Errorf("adaptivecard: refresh supports at most %d user IDs, got %d", MaxRefreshUserIDs, len(userIDs))
+	}
+
+	c.Refresh = &Refresh{
+		Action:  NewExecuteAction("Refresh", verb, data).flat(),
+		UserIDs: userIDs,
+	}
+	return nil
+}
+
+// Authentication tells Teams how to obtain a token for the user before
+// sending Universal Actions, either silently via single sign-on
+// (TokenExchangeResource) or by showing Buttons.
+type Authentication struct {
+	// Text is shown above the buttons when the user has to sign in.
+	Text string `json:"text,omitempty"`
+	// ConnectionName is the OAuth connection configured on the bot.
+	ConnectionName        string                 `json:"connectionName,omitempty"`
+	TokenExchangeResource *TokenExchangeResource `json:"tokenExchangeResource,omitempty"`
+	Buttons               []AuthCardButton       `json:"buttons,omitempty"`
+}
+
+// TokenExchangeResource identifies the Azure AD app Teams exchanges the
+// user's token for during single sign-on.
+type TokenExchangeResource struct {
+	ID         string `json:"id"`
+	URI        string `json:"uri"`
+	ProviderID string `json:"providerId"`
+}
+
+// AuthCardButton is a button shown when sign-in is required; Type "signin"
+// opens Value as the sign-in URL.
+type AuthCardButton struct {
+	Type  string `json:"type"`
+	Title string `json:"title,omitempty"`
+	Image string `json:"image,omitempty"`
+	Value string `json:"value"`
+}
+
+func NewSignInButton(title, url string) AuthCardButton {
+	return AuthCardButton{
+		Type:  "signin",
+		Title: title,
+		Value: url,
+	}
+}
+
+// EnableAuthentication sets how the card's Universal Actions authenticate
+// the user: with single sign-on through resource when it is not nil, or
+// otherwise through connectionName and the sign-in buttons.
+func (c *AdaptiveCard) EnableAuthentication(connectionName string, resource *TokenExchangeResource, buttons ...AuthCardButton) {
+	c.Authentication = &Authentication{
+		ConnectionName:        connectionName,
+		TokenExchangeResource: resource,
+		Buttons:               buttons,
+	}
+}
@@ -0,0 +1,134 @@
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrTemplateNotFound is returned for unknown template names or versions.
+var ErrTemplateNotFound = errors.New("adaptivecard: template not found")
+
+// BuildFunc builds a card from template data.
+type BuildFunc func(data any) (AdaptiveCard, error)
+
+// Template is a named, versioned card builder. Its identifier is
+// "name.vN", e.g. "alert.v2".
+type Template struct {
+	Name        string
+	Version     int
+	Description string
+	Build       BuildFunc
+}
+
+func (t Template) ID() string {
+	return fmt.Sprintf("%s.v%d", t.Name, t.Version)
+}
+
+// Registry maps template identifiers to builders, so services can route an
+// identifier carried by an event to the card constructor for it. It is safe
+// for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	templates map[string]map[int]Template
+}
+
+func NewRegistry() *Registry {
+	return &Registry{templates: map[string]map[int]Template{}}
+}
+
+// DefaultRegistry is the process-wide registry used by RegisterTemplate.
+var DefaultRegistry = NewRegistry()
+
+// RegisterTemplate adds t to DefaultRegistry.
+func RegisterTemplate(t Template) error {
+	return DefaultRegistry.Register(t)
+}
+
+// Register adds t. Registering the same name and version twice is an error.
+func (r *Registry) Register(t Template) error {
+	if t.Name == "" || t.Version < 1 || t.Build == nil {
+		return fmt.Errorf("adaptivecard: template needs a name, a version >= 1 and a Build func")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions, ok := r.templates[t.Name]
+	if !ok {
+		versions = map[int]Template{}
+		r.templates[t.Name] = versions
+	}
+	if _, dup := versions[t.Version]; dup {
+		return fmt.Errorf("adaptivecard: template %s is already registered", t.ID())
+	}
+	versions[t.Version] = t
+	return nil
+}
+
+// Get returns the template for id: "name.vN" for a specific version, or a
+// bare "name" for the latest one.
+func (r *Registry) Get(id string) (Template, error) {
+	name, version := parseTemplateID(id)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	versions := r.templates[name]
+	if version == 0 {
+		for v := range versions {
+			version = max(version, v)
+		}
+	}
+	t, ok := versions[version]
+	if !ok {
+		return Template{}, fmt.Errorf("%w: %s", ErrTemplateNotFound, id)
+	}
+	return t, nil
+}
+
+// Build looks up id and builds its card from data.
+func (r *Registry) Build(id string, data any) (AdaptiveCard, error) {
+	t, err := r.Get(id)
+	if err != nil {
+		return AdaptiveCard{}, err
+	}
+	card, err := t.Build(data)
+	if err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: building template %s: %w", t.ID(), err)
+	}
+	return card, nil
+}
+
+// List returns every registered template, sorted by name and version.
+func (r *Registry) List() []Template {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []Template
+	for _, versions := range r.templates {
+		for _, t := range versions {
+			out = append(out, t)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].Version < out[j].Version
+	})
+	return out
+}
+
+// parseTemplateID splits "alert.v2" into ("alert", 2); ids without a version
+// suffix return version 0.
+func parseTemplateID(id string) (string, int) {
+	i := strings.LastIndex(id, ".v")
+	if i < 0 {
+		return id, 0
+	}
+	v, err := strconv.Atoi(id[i+2:])
+	if err != nil || v < 1 {
+		return id, 0
+	}
+	return id[:i], v
+}
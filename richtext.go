@@ -0,0 +1,104 @@
+package adaptivecard
+
+// ----------------------
+// RichTextBlock
+// ----------------------
+type RichTextBlock struct {
+	Type string `json:"type"`
+	BaseElement
+	Inlines []TextRun `json:"inlines"`
+}
+
+type TextRun struct {
+	Type          string  `json:"type"`
+	Text          string  `json:"text"`
+	Weight        Weight  `json:"weight,omitempty"`
+	Size          Size    `json:"size,omitempty"`
+	Color         Color   `json:"color,omitempty"`
+	IsSubtle      bool    `json:"isSubtle,omitempty"`
+	Italic        bool    `json:"italic,omitempty"`
+	Strikethrough bool    `json:"strikethrough,omitempty"`
+	Underline     bool    `json:"underline,omitempty"`
+	Highlight     bool    `json:"highlight,omitempty"`
+	SelectAction  *Action `json:"selectAction,omitempty"`
+}
+
+func NewRichTextBlock(inlines ...TextRun) RichTextBlock {
+	return RichTextBlock{
+		Type:    "RichTextBlock",
+		Inlines: inlines,
+	}
+}
+func (RichTextBlock) isElement() {}
+func (r RichTextBlock) toRaw() any {
+	return r
+}
+
+func (r *RichTextBlock) AddInline(run TextRun) {
+	r.Inlines = append(r.Inlines, run)
+}
+
+func NewTextRun(text string) TextRun {
+	return TextRun{
+		Type: "TextRun",
+		Text: text,
+	}
+}
+
+// NewLinkTextRun returns a TextRun that opens url when tapped, so links can sit
+// mid-sentence instead of being separate buttons.
+func NewLinkTextRun(text, url string) TextRun {
+	run := NewTextRun(text)
+	run.WithSelectAction(NewOpenUrlAction(text, url))
+	return run
+}
+
+// NewChangeRuns renders a value change inline: the old value struck through,
+// followed by the new value in bold.
+func NewChangeRuns(oldValue, newValue string) []TextRun {
+	old := NewTextRun(oldValue)
+	old.WithStrikethrough()
+
+	updated := NewTextRun(" " + newValue)
+	updated.WithWeight(WeightBolder)
+
+	return []TextRun{old, updated}
+}
+
+func (tr *TextRun) WithSelectAction(action ActionElement) {
+	a := action.flat()
+	tr.SelectAction = &a
+}
+
+func (tr *TextRun) WithWeight(weight Weight) {
+	tr.Weight = weight
+}
+
+func (tr *TextRun) WithSize(size Size) {
+	tr.Size = size
+}
+
+func (tr *TextRun) WithColor(color Color) {
+	tr.Color = color
+}
+
+func (tr *TextRun) WithSubtle() {
+	tr.IsSubtle = true
+}
+
+// WithHighlight renders the run with a highlighted (marker) background.
+func (tr *TextRun) WithHighlight() {
+	tr.Highlight = true
+}
+
+func (tr *TextRun) WithItalic() {
+	tr.Italic = true
+}
+
+func (tr *TextRun) WithStrikethrough() {
+	tr.Strikethrough = true
+}
+
+func (tr *TextRun) WithUnderline() {
+	tr.Underline = true
+}
@@ -0,0 +1,156 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestParseRoundTripGolden parses each card in testdata/roundtrip and checks
+// that marshaling it reproduces the input, property for property.
+func TestParseRoundTripGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "roundtrip", "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no golden cards: %v", err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			in, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			card, err := ParseCard(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := json.Marshal(card)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want, got any
+			if err := json.Unmarshal(in, &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("round trip differs\n got: %s\nwant: %s", out, in)
+			}
+		})
+	}
+}
+
+func TestParseKeepsUnknownProperties(t *testing.T) {
+	in, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "extras.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := ParseCard(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		extra map[string]json.RawMessage
+		key   string
+	}{
+		{"card", card.Extra, "rtl"},
+		{"column", card.Body[0].(ColumnSet).Columns[0].Extra, "backgroundImage"},
+		{"row", card.Body[1].(Table).Rows[0].Extra, "rtl"},
+		{"cell", card.Body[1].(Table).Rows[0].Cells[0].Extra, "minHeight"},
+		{"typed action", card.Actions[0].(OpenUrlAction).Extra, "fallback"},
+		{"nested card", card.Actions[1].(ShowCardAction).Card.Extra, "minHeight"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := tt.extra[tt.key]; !ok {
+				t.Errorf("Extra = %v, want key %q", tt.extra, tt.key)
+			}
+		})
+	}
+}
+
+func TestParseCommonActionProperties(t *testing.T) {
+	in, err := os.ReadFile(filepath.Join("testdata", "roundtrip", "extras.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := ParseCard(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := card.Actions[0].(OpenUrlAction)
+	if open.IconURL != "https://example.com/icon.png" || open.Style != "positive" || open.Tooltip != "Open the run" {
+		t.Errorf("OpenUrlAction = %+v", open)
+	}
+	if show := card.Actions[1].(ShowCardAction); show.IsEnabled == nil || *show.IsEnabled {
+		t.Errorf("ShowCardAction.IsEnabled = %v, want false", show.IsEnabled)
+	}
+	if _, ok := open.Extra["tooltip"]; ok {
+		t.Error("tooltip is kept in Extra as well as in Tooltip")
+	}
+}
+
+// TestParseRoundTrip checks single elements and actions: marshaling the
+// parsed card reproduces the input, or want when the package normalizes a
+// shorthand form.
+func TestParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "TextBlock", body: `{"type":"TextBlock","text":"hi","weight":"bolder","size":"large","wrap":true}`},
+		{name: "RichTextBlock", body: `{"type":"RichTextBlock","inlines":[{"type":"TextRun","text":"a","italic":true}]}`},
+		{name: "Image", body: `{"type":"Image","url":"https://example.com/a.png","altText":"a","size":"small"}`},
+		{name: "FactSet", body: `{"type":"FactSet","facts":[{"title":"k","value":"v"}]}`},
+		{name: "Container", body: `{"type":"Container","style":"good","items":[{"type":"TextBlock","text":"x"}]}`},
+		{name: "ColumnSet", body: `{"type":"ColumnSet","columns":[{"type":"Column","width":"auto","items":[{"type":"TextBlock","text":"x"}]}]}`},
+		{name: "Table", body: `{"type":"Table","columns":[{"width":1}],"rows":[{"type":"TableRow","cells":[{"type":"TableCell","items":[{"type":"TextBlock","text":"x"}]}]}]}`},
+		{name: "Input.Text", body: `{"type":"Input.Text","id":"t","label":"Name","isMultiline":true}`},
+		{name: "Input.Toggle", body: `{"type":"Input.Toggle","id":"ok","title":"OK"}`},
+		{name: "ActionSet", body: `{"type":"ActionSet","actions":[{"type":"Action.Submit","title":"Go","data":{"k":1}}]}`},
+		{name: "Icon", body: `{"type":"Icon","name":"Calendar"}`},
+		{name: "unknown type", body: `{"type":"Carousel","pages":[]}`},
+		{name: "fallback", body: `{"type":"Image","url":"https://example.com/a.png","fallback":"drop"}`},
+		{
+			name: "empty Table",
+			body: `{"type":"Table"}`,
+			want: `{"type":"Table","rows":[]}`,
+		},
+		{
+			name: "background image shorthand",
+			body: `{"type":"Container","backgroundImage":"https://example.com/bg.png","items":[]}`,
+			want: `{"type":"Container","backgroundImage":{"url":"https://example.com/bg.png"},"items":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := func(body string) string {
+				return `{"type":"AdaptiveCard","version":"1.5","$schema":"","body":[` + body + `]}`
+			}
+			parsed, err := ParseCard([]byte(card(tt.body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := tt.want
+			if want == "" {
+				want = tt.body
+			}
+			var wantV, gotV any
+			if err := json.Unmarshal([]byte(card(want)), &wantV); err != nil {
+				t.Fatal(err)
+			}
+			got := mustMarshal(t, parsed)
+			if err := json.Unmarshal([]byte(got), &gotV); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotV, wantV) {
+				t.Errorf("round trip differs\n got: %s\nwant: %s", got, card(want))
+			}
+		})
+	}
+}
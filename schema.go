@@ -0,0 +1,62 @@
+package adaptivecard
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/luisdibdin/adaptivecard/internal/jsonschema"
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// bundledSchema is the Adaptive Card schema shipped with the package. It
+// follows the layout of the one published at
+// http://adaptivecards.io/schemas/adaptive-card.json and also describes the
+// Teams-only Icon, Badge and ProgressBar elements.
+//
+//go:embed schemas/adaptive-card.json
+var bundledSchema []byte
+
+var compileBundledSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	return jsonschema.Compile(bundledSchema)
+})
+
+// ValidateSchema validates the marshaled card against the bundled schema and
+// returns one ValidationError per violation with the JSON path of the
+// offending property. Middleware registered with Use does not run. The error
+// is only non-nil when the card cannot be marshaled.
+func (c AdaptiveCard) ValidateSchema() ([]ValidationError, error) {
+	s, err := compileBundledSchema()
+	if err != nil {
+		return nil, fmt.Errorf("adaptivecard: bundled schema: %w", err)
+	}
+	return c.validateSchema(s)
+}
+
+// ValidateAgainstSchema is ValidateSchema with a schema of your choosing,
+// such as a newer copy of the official one pinned in your repository. The
+// error is only non-nil when the schema or the card cannot be processed.
+func (c AdaptiveCard) ValidateAgainstSchema(schema []byte) ([]ValidationError, error) {
+	s, err := jsonschema.Compile(schema)
+	if err != nil {
+		return nil, fmt.Errorf("adaptivecard: %w", err)
+	}
+	return c.validateSchema(s)
+}
+
+func (c AdaptiveCard) validateSchema(s *jsonschema.Schema) ([]ValidationError, error) {
+	data, err := json.Marshal(c.withoutMiddleware())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := orderedjson.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("adaptivecard: %w", err)
+	}
+	var errs []ValidationError
+	for _, e := range s.Validate(tree) {
+		errs = append(errs, ValidationError{Path: e.Path, Rule: RuleSchema, Message: e.Message})
+	}
+	return errs, nil
+}
@@ -0,0 +1,61 @@
+package adaptivecard
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateSchemaAcceptsValidCards(t *testing.T) {
+	for _, path := range []string{
+		"testdata/downgrade/kitchensink.json",
+		"testdata/roundtrip/extras.json",
+	} {
+		t.Run(path, func(t *testing.T) {
+			errs, err := loadCard(t, path).ValidateSchema()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range errs {
+				t.Errorf("%s: %s", e.Path, e.Message)
+			}
+		})
+	}
+}
+
+func TestValidateSchemaReportsViolations(t *testing.T) {
+	tests := []struct {
+		name string
+		card AdaptiveCard
+		path string
+	}{
+		{
+			name: "bad enum",
+			card: newTestCard(NewTextBlock("hi", WithSize("huge"))),
+			path: "$.body[0].size",
+		},
+		{
+			name: "bad action style",
+			card: newTestCard(NewContainer(NewActionSet(OpenUrlAction{Type: "Action.OpenUrl", URL: "https://example.com", Style: "loud"}))),
+			path: "$.body[0].items[0].actions[0].style",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, err := tt.card.ValidateSchema()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var paths []string
+			for _, e := range errs {
+				if e.Rule != RuleSchema {
+					t.Errorf("rule = %q, want %q", e.Rule, RuleSchema)
+				}
+				if e.Path == tt.path {
+					return
+				}
+				paths = append(paths, e.Path+": "+e.Message)
+			}
+			t.Errorf("no error at %s; got:\n%s", tt.path, strings.Join(paths, "\n"))
+		})
+	}
+}
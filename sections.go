@@ -0,0 +1,61 @@
+package adaptivecard
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Section is a titled part of a long card whose items can be expanded and
+// collapsed from the card's navigation row.
+type Section struct {
+	ID    string
+	Title string
+	Items []Element
+}
+
+// AddSections appends each section to the body as a heading TextBlock followed
+// by a Container holding its items, and adds one Action.ToggleVisibility per
+// section to the card actions so readers can jump straight to the part they
+// care about. Headings get the section ID (derived from the title when empty)
+// and the content container gets the same ID with a "-content" suffix.
+func (c *AdaptiveCard) AddSections(collapsed bool, sections ...Section) {
+	for _, s := range sections {
+		id := s.ID
+		if id == "" {
+			id = sectionID(s.Title)
+		}
+
+		heading := NewTextBlock(s.Title)
+		heading.WithID(id)
+		heading.WithStyle("heading")
+		heading.WithWeight(WeightBolder)
+		heading.WithSize(SizeMedium)
+		heading.WithSeparator()
+		c.AddBody(heading)
+
+		content := NewContainer(s.Items...)
+		content.WithID(id + "-content")
+		if collapsed {
+			content.WithVisible(false)
+		}
+		c.AddBody(content)
+
+		c.AddAction(NewToggleVisibilityAction(s.Title, content.ID))
+	}
+}
+
+// sectionID turns a heading title into a lowercase, dash separated element ID.
+func sectionID(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,70 @@
+package adaptivecard
+
+import "strings"
+
+// Severity is an alert severity with a single mapping to the colors and
+// styles of every element, so "critical" looks the same in a TextBlock, a
+// Container, a Badge or a chart.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+	SeverityInfo     Severity = "info"
+)
+
+// ParseSeverity maps s case-insensitively to a Severity, treating unknown
+// values as SeverityInfo.
+func ParseSeverity(s string) Severity {
+	switch sev := Severity(strings.ToLower(strings.TrimSpace(s))); sev {
+	case SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow:
+		return sev
+	}
+	return SeverityInfo
+}
+
+// severityStyle holds every rendering of one severity.
+type severityStyle struct {
+	label           string
+	textColor       Color
+	containerStyle  ContainerStyle
+	badgeStyle      string
+	badgeAppearance string
+	chartColor      string
+	icon            IconName
+}
+
+var severityStyles = map[Severity]severityStyle{
+	SeverityCritical: {"Critical", "attention", "attention", "Attention", "Filled", "attention", IconErrorCircle},
+	SeverityHigh:     {"High", "warning", "warning", "Warning", "Filled", "categoricalMarigold", IconWarning},
+	SeverityMedium:   {"Medium", "warning", "warning", "Warning", "Tint", "warning", IconWarning},
+	SeverityLow:      {"Low", "accent", "accent", "Informative", "Tint", "categoricalBlue", IconInfo},
+	SeverityInfo:     {"Info", "default", "emphasis", "Default", "Tint", "neutral", IconInfo},
+}
+
+func (s Severity) style() severityStyle {
+	return severityStyles[ParseSeverity(string(s))]
+}
+
+// Label is the display name, e.g. "Critical".
+func (s Severity) Label() string { return s.style().label }
+
+// TextColor is the TextBlock/TextRun color for the severity.
+func (s Severity) TextColor() Color { return s.style().textColor }
+
+// ContainerStyle is the Container (or table row) style for the severity.
+func (s Severity) ContainerStyle() ContainerStyle { return s.style().containerStyle }
+
+// BadgeStyle is the Badge style for the severity.
+func (s Severity) BadgeStyle() string { return s.style().badgeStyle }
+
+// BadgeAppearance is "Filled" for critical and high, "Tint" otherwise.
+func (s Severity) BadgeAppearance() string { return s.style().badgeAppearance }
+
+// ChartColor is the Teams chart color name for the severity.
+func (s Severity) ChartColor() string { return s.style().chartColor }
+
+// Icon is the Fluent icon shown next to the severity.
+func (s Severity) Icon() IconName { return s.style().icon }
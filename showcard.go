@@ -0,0 +1,214 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// NewShowCardAction returns an Action.ShowCard that expands card inline below
+// the action row, e.g. a "More details" button. The nested card's version
+// and $schema are inherited from the outer card and omitted when empty.
+func NewShowCardAction(title string, card AdaptiveCard) ShowCardAction {
+	card.Type = "AdaptiveCard"
+	return ShowCardAction{
+		Type:  "Action.ShowCard",
+		Title: title,
+		Card:  &card,
+	}
+}
+
+// showCardJSON is cardJSON without the version and $schema a nested card
+// does not need.
+type showCardJSON struct {
+	Type           string          `json:"type"`
+	Version        string          `json:"version,omitempty"`
+	Body           []any           `json:"body"`
+	Schema         string          `json:"$schema,omitempty"`
+	Actions        []any           `json:"actions,omitempty"`
+	MSTeams        *MSTeamsInfo    `json:"msteams,omitempty"`
+	Refresh        *Refresh        `json:"refresh,omitempty"`
+	Authentication *Authentication `json:"authentication,omitempty"`
+	SelectAction   *Action         `json:"selectAction,omitempty"`
+	FallbackText   string          `json:"fallbackText,omitempty"`
+	Speak          string          `json:"speak,omitempty"`
+	Lang           string          `json:"lang,omitempty"`
+
+	extra map[string]json.RawMessage
+}
+
+func (a Action) MarshalJSON() ([]byte, error) {
+	type plain Action
+	if a.Card == nil {
+		return json.Marshal(extraOf(plain(a), a.Extra))
+	}
+	card := *a.Card
+	if card.Type == "" {
+		card.Type = "AdaptiveCard"
+	}
+	raw, err := card.raw()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(extraOf(struct {
+		plain
+		Card any `json:"card"`
+	}{plain(a), extraOf(showCardJSON(raw), raw.extra)}, a.Extra))
+}
+
+// MaxShowCardDepthTeams is how deep Action.ShowCard cards can nest in Teams:
+// a ShowCard may appear on the card and inside its revealed card, but
+// deeper levels render inconsistently across clients.
+const MaxShowCardDepthTeams = 2
+
+// ValidateShowCards checks Action.ShowCard usage:
+//
+//   - ShowCards nest at most maxDepth levels; 1 forbids ShowCard inside
+//     ShowCard and 0 forbids ShowCard altogether.
+//   - ShowCard is not used as a selectAction, where hosts ignore it.
+//   - Input IDs are unique across a card and its nested cards: a submit
+//     inside a ShowCard sends the inputs of all enclosing cards too, so
+//     duplicates overwrite each other.
+//   - A ShowCard with inputs has its own submit or execute action; the
+//     parent's actions do not collect inputs from revealed cards.
+func (c AdaptiveCard) ValidateShowCards(maxDepth int) error {
+	v := showCardValidator{maxDepth: maxDepth}
+	v.card(c, "$", 0, map[string]string{})
+	return errors.Join(v.errs...)
+}
+
+type showCardValidator struct {
+	maxDepth int
+	errs     []error
+}
+
+// card validates one card at depth, given the input IDs (and their paths)
+// declared by its enclosing cards.
+func (v *showCardValidator) card(c AdaptiveCard, path string, depth int, inherited map[string]string) {
+	inputs := make(map[string]string, len(inherited))
+	for id, p := range inherited {
+		inputs[id] = p
+	}
+	hasInputs := false
+	hasSubmitAction := hasSubmit(flatActions(c.Actions))
+	rows := []actionRow{{path + ".actions", flatActions(c.Actions)}}
+	if c.SelectAction != nil && c.SelectAction.Type == "Action.ShowCard" {
+		v.errorf("%s.selectAction: Action.ShowCard cannot be used as a selectAction", path)
+	}
+
+	_ = walk(c.Body, path+".body", func(path string, el Element) error {
+		if as, ok := el.(ActionSet); ok {
+			hasSubmitAction = hasSubmitAction || hasSubmit(flatActions(as.Actions))
+			rows = append(rows, actionRow{path + ".actions", flatActions(as.Actions)})
+		}
+		if id, ok := inputID(el); ok {
+			hasInputs = true
+			if prev, dup := inputs[id]; dup {
+				v.errorf("%s: input id %q is already used at %s", path, id, prev)
+			} else {
+				inputs[id] = path
+			}
+		}
+		for _, sel := range selectActions(path, el) {
+			if sel.action.Type == "Action.ShowCard" {
+				v.errorf("%s: Action.ShowCard cannot be used as a selectAction", sel.path)
+			}
+		}
+		return nil
+	})
+
+	if depth > 0 && hasInputs && !hasSubmitAction {
+		v.errorf("%s: card has inputs but no Action.Submit or Action.Execute to send them", path)
+	}
+
+	for _, row := range rows {
+		for i, a := range row.actions {
+			if a.Type != "Action.ShowCard" {
+				continue
+			}
+			actionPath := fmt.Sprintf("%s[%d]", row.path, i)
+			switch {
+			case a.Card == nil:
+				v.errorf("%s: Action.ShowCard has no card", actionPath)
+			case depth+1 > v.maxDepth:
+				v.errorf("%s: Action.ShowCard nested %d levels deep, the host allows %d", actionPath, depth+1, v.maxDepth)
+			default:
+				v.card(*a.Card, actionPath+".card", depth+1, inputs)
+			}
+		}
+	}
+}
+
+// actionRow is the card's action row or an ActionSet, with its JSON path.
+type actionRow struct {
+	path    string
+	actions []Action
+}
+
+func (v *showCardValidator) errorf(format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf("adaptivecard: "+format, args...))
+}
+
+func hasSubmit(actions []Action) bool {
+	for _, a := range actions {
+		if a.Type == "Action.Submit" || a.Type == "Action.Execute" {
+			return true
+		}
+	}
+	return false
+}
+
+// inputID returns the ID of an input element.
+func inputID(el Element) (string, bool) {
+	in, ok := inputFields(el)
+	return in.ID, ok
+}
+
+// inputFields returns the shared fields of an input element.
+func inputFields(el Element) (InputFields, bool) {
+	switch el := el.(type) {
+	case ChoiceSetInput:
+		return el.InputFields, true
+	case DateInput:
+		return el.InputFields, true
+	case TimeInput:
+		return el.InputFields, true
+	case NumberInput:
+		return el.InputFields, true
+	}
+	return InputFields{}, false
+}
+
+type pathAction struct {
+	path   string
+	action Action
+}
+
+// selectActions returns the selectActions set on el, its inline runs and its
+// columns.
+func selectActions(path string, el Element) []pathAction {
+	var out []pathAction
+	add := func(path string, a *Action) {
+		if a != nil {
+			out = append(out, pathAction{path, *a})
+		}
+	}
+	switch el := el.(type) {
+	case Container:
+		add(path+".selectAction", el.SelectAction)
+	case ColumnSet:
+		add(path+".selectAction", el.SelectAction)
+		for i, col := range el.Columns {
+			add(fmt.Sprintf("%s.columns[%d].selectAction", path, i), col.SelectAction)
+		}
+	case Image:
+		add(path+".selectAction", el.SelectAction)
+	case RichTextBlock:
+		for i, run := range el.Inlines {
+			add(fmt.Sprintf("%s.inlines[%d].selectAction", path, i), run.SelectAction)
+		}
+	case Icon:
+		add(path+".selectAction", el.SelectAction)
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Stats summarizes the size and complexity of a card.
+type Stats struct {
+	// Elements counts body elements by type, including nested ones.
+	Elements map[string]int `json:"elements"`
+	// MaxDepth is the deepest element nesting; top-level elements are at
+	// depth 1.
+	MaxDepth int `json:"maxDepth"`
+	// TextLength is the number of characters in all user-visible text.
+	TextLength int `json:"textLength"`
+	// Actions counts card actions, selectActions and the actions of cards
+	// revealed by Action.ShowCard.
+	Actions int `json:"actions"`
+	Images  int `json:"images"`
+}
+
+// Stats returns element counts, nesting depth, text length, action and image
+// counts, e.g. to flag unusually large generated cards in monitoring.
+func (c AdaptiveCard) Stats() Stats {
+	s := Stats{Elements: map[string]int{}}
+	s.add(c, 0)
+	return s
+}
+
+func (s *Stats) add(c AdaptiveCard, depth int) {
+	walkDepth(c.Body, "$.body", depth+1, func(path string, depth int, el Element) {
+		s.Elements[elementType(el)]++
+		s.MaxDepth = max(s.MaxDepth, depth)
+		if _, ok := el.(Image); ok {
+			s.Images++
+		}
+		elementTexts(path, el, func(_, text string) {
+			s.TextLength += utf8.RuneCountInString(text)
+		})
+		s.Actions += len(selectActions(path, el))
+		if as, ok := el.(ActionSet); ok {
+			for _, a := range flatActions(as.Actions) {
+				s.Actions++
+				if a.Card != nil {
+					s.add(*a.Card, depth)
+				}
+			}
+		}
+	})
+	for _, a := range flatActions(c.Actions) {
+		s.Actions++
+		s.TextLength += utf8.RuneCountInString(a.Title)
+		if a.Card != nil {
+			s.add(*a.Card, depth)
+		}
+	}
+	if c.SelectAction != nil {
+		s.Actions++
+	}
+}
+
+// walkDepth is walk with the nesting depth of each element, starting at
+// depth for the given elements.
+func walkDepth(elements []Element, path string, depth int, fn func(path string, depth int, el Element)) {
+	for i, el := range elements {
+		walkDepthElement(el, fmt.Sprintf("%s[%d]", path, i), depth, fn)
+	}
+}
+
+func walkDepthElement(el Element, path string, depth int, fn func(path string, depth int, el Element)) {
+	if el = unwrapConditional(el); el == nil {
+		return
+	}
+	fn(path, depth, el)
+	if p, ok := el.(parent); ok {
+		for _, c := range p.children() {
+			walkDepthElement(c.el, path+"."+c.path, depth+1, fn)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package adaptivecard
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// NewFactSetFromStruct returns a FactSet with one fact per exported field of
+// v, a struct or pointer to struct, in declaration order. Fields of embedded
+// structs are included in place. The `fact` tag renames a field or skips it:
+//
+//	type Alert struct {
+//		Service  string `fact:"Service"`
+//		Region   string `fact:"Region,omitempty"`
+//		Internal string `fact:"-"`
+//	}
+//
+// omitempty leaves out zero values. Times are shown in UTC; other values are
+// formatted with fmt, slices as comma-separated lists.
+func NewFactSetFromStruct(v any) (FactSet, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return FactSet{}, fmt.Errorf("adaptivecard: fact set: %w", err)
+	}
+	fs := NewFactSet()
+	for _, f := range structFields(rv.Type(), "fact") {
+		fv, ok := f.value(rv)
+		if f.omitEmpty && (!ok || fv.IsZero()) {
+			continue
+		}
+		fs.Facts = append(fs.Facts, Fact{Title: f.name, Value: valueText(fv, ok)})
+	}
+	return fs, nil
+}
+
+// structField is an exported struct field as named by a struct tag.
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields lists the exported fields of t, including those promoted
+// from embedded structs, named by tag, e.g. `fact:"Title,omitempty"`.
+// Fields tagged "-" are skipped.
+func structFields(t reflect.Type, tag string) []structField {
+	var out []structField
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous && indirect(f.Type).Kind() == reflect.Struct {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		out = append(out, structField{
+			name:      name,
+			index:     f.Index,
+			omitEmpty: opts == "omitempty",
+		})
+	}
+	return out
+}
+
+// value returns the field in rv; ok is false when it sits behind a nil
+// embedded pointer.
+func (f structField) value(rv reflect.Value) (reflect.Value, bool) {
+	fv, err := rv.FieldByIndexErr(f.index)
+	return fv, err == nil
+}
+
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, fmt.Errorf("nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%T is not a struct", v)
+	}
+	return rv, nil
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// valueText renders a field value for display.
+func valueText(v reflect.Value, ok bool) string {
+	for ok && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return ""
+		}
+		if _, stringer := v.Interface().(fmt.Stringer); stringer {
+			break
+		}
+		v = v.Elem()
+	}
+	if !ok || !v.IsValid() {
+		return ""
+	}
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format("2006-01-02 15:04 UTC")
+	}
+	if _, stringer := v.Interface().(fmt.Stringer); !stringer && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8 {
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = valueText(v.Index(i), true)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// NewTableFromStructs returns a Table with a header row and one row per
+// non-nil element of rows, a slice of structs or struct pointers. Columns follow the
+// exported fields in declaration order, named and skipped with the `table`
+// tag (`table:"CVE"`, `table:"-"`), and each cell is a TextBlock formatted
+// like NewFactSetFromStruct values. TableMaxRows and TableHeader apply as
+// for NewTableFromCSV.
+func NewTableFromStructs(rows any, opts ...TableOption) (Table, error) {
+	o := newTableOptions(opts)
+
+	rv := reflect.ValueOf(rows)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return Table{}, fmt.Errorf("adaptivecard: table: %T is not a slice", rows)
+	}
+	elem := indirect(rv.Type().Elem())
+	if elem.Kind() != reflect.Struct {
+		return Table{}, fmt.Errorf("adaptivecard: table: %s is not a struct", elem)
+	}
+
+	fields := structFields(elem, "table")
+	header := make([]string, len(fields))
+	for j, f := range fields {
+		header[j] = f.name
+	}
+	records := [][]string{header}
+	for i := 0; i < rv.Len(); i++ {
+		row, err := structValue(rv.Index(i).Interface())
+		if err != nil {
+			continue // nil pointer
+		}
+		record := make([]string, len(fields))
+		for j, f := range fields {
+			record[j] = valueText(f.value(row))
+		}
+		records = append(records, record)
+	}
+	return o.table(records, true), nil
+}
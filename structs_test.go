@@ -0,0 +1,41 @@
+package adaptivecard
+
+import "testing"
+
+func TestNewTableFromStructsTruncates(t *testing.T) {
+	type vuln struct {
+		CVE      string `table:"CVE"`
+		Severity string
+		internal int
+	}
+	type opaque struct {
+		internal int
+	}
+
+	tests := []struct {
+		name     string
+		rows     any
+		wantCols int
+		wantRows int
+	}{
+		{"under the limit", make([]vuln, 3), 2, 1 + 3},
+		{"over the limit", make([]vuln, DefaultTableMaxRows+5), 2, 1 + DefaultTableMaxRows + 1},
+		{"no exported fields", make([]opaque, DefaultTableMaxRows+5), 0, 1 + DefaultTableMaxRows},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := NewTableFromStructs(tt.rows)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(table.Columns) != tt.wantCols || len(table.Rows) != tt.wantRows {
+				t.Errorf("got %d columns and %d rows, want %d and %d", len(table.Columns), len(table.Rows), tt.wantCols, tt.wantRows)
+			}
+			for i, row := range table.Rows {
+				if len(row.Cells) != tt.wantCols {
+					t.Errorf("row %d has %d cells, want %d", i, len(row.Cells), tt.wantCols)
+				}
+			}
+		})
+	}
+}
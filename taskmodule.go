@@ -0,0 +1,58 @@
+package adaptivecard
+
+// Task module (dialog) sizes. Height and width may also be given in pixels.
+const (
+	TaskSizeSmall  = "small"
+	TaskSizeMedium = "medium"
+	TaskSizeLarge  = "large"
+)
+
+// TaskModuleResponse is the body a bot returns for task/fetch and
+// task/submit invokes.
+type TaskModuleResponse struct {
+	Task TaskModuleResult `json:"task"`
+}
+
+// TaskModuleResult is either a "continue" result showing a dialog, with a
+// *TaskInfo value, or a "message" result closing it, with a string value.
+type TaskModuleResult struct {
+	Type  string `json:"type"`
+	Value any    `json:"value,omitempty"`
+}
+
+// TaskInfo describes the dialog to show. Height and Width take one of the
+// TaskSize constants or a number of pixels.
+type TaskInfo struct {
+	Title  string      `json:"title,omitempty"`
+	Height any         `json:"height,omitempty"`
+	Width  any         `json:"width,omitempty"`
+	Card   *Attachment `json:"card,omitempty"`
+	URL    string      `json:"url,omitempty"`
+}
+
+// NewTaskContinue shows card in a dialog, or replaces the current dialog's
+// card when returned from task/submit.
+func NewTaskContinue(title string, card AdaptiveCard) TaskModuleResponse {
+	attachment := NewAttachment(card)
+	return TaskModuleResponse{Task: TaskModuleResult{
+		Type: "continue",
+		Value: &TaskInfo{
+			Title: title,
+			Card:  &attachment,
+		},
+	}}
+}
+
+// NewTaskMessage closes the dialog and shows message to the user.
+func NewTaskMessage(message string) TaskModuleResponse {
+	return TaskModuleResponse{Task: TaskModuleResult{Type: "message", Value: message}}
+}
+
+// WithSize sets the dialog height and width (TaskSize constants or pixels)
+// of a continue response; it has no effect on message responses.
+func (r *TaskModuleResponse) WithSize(height, width any) {
+	if info, ok := r.Task.Value.(*TaskInfo); ok {
+		info.Height = height
+		info.Width = width
+	}
+}
@@ -0,0 +1,100 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// ActionRequest is a decoded adaptiveCard/action invoke.
+type ActionRequest struct {
+	Activity adaptivecard.InvokeActivity
+	Value    adaptivecard.ActionInvokeValue
+}
+
+// Verb returns the verb of the Action.Execute that was pressed.
+func (r ActionRequest) Verb() string {
+	return r.Value.Action.Verb
+}
+
+// ActionHandlerFunc handles one Action.Execute verb.
+type ActionHandlerFunc func(ctx context.Context, req ActionRequest) (adaptivecard.ActionInvokeResponse, error)
+
+// ActionRouter is an http.Handler for adaptiveCard/action invokes that
+// dispatches on the action verb. It does not authenticate the Bot Framework
+// token; put it behind the middleware your bot already uses for that.
+type ActionRouter struct {
+	mu       sync.RWMutex
+	handlers map[string]ActionHandlerFunc
+	logger   *slog.Logger
+}
+
+func NewActionRouter() *ActionRouter {
+	return &ActionRouter{handlers: map[string]ActionHandlerFunc{}}
+}
+
+// Handle registers fn for verb, replacing any earlier handler.
+func (r *ActionRouter) Handle(verb string, fn ActionHandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[verb] = fn
+}
+
+// SetLogger logs handler errors, which are otherwise only reported to Teams
+// as a generic error response.
+func (r *ActionRouter) SetLogger(logger *slog.Logger) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logger = logger
+}
+
+func (r *ActionRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxOutgoingBody))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+
+	activity, err := adaptivecard.DecodeInvokeActivity(body)
+	if err != nil || activity.Name != adaptivecard.InvokeAdaptiveCardAction {
+		writeJSON(w, http.StatusOK, adaptivecard.NewErrorResponse(http.StatusBadRequest, "BadRequest",
+			"expected an adaptiveCard/action invoke"))
+		return
+	}
+	value, err := adaptivecard.DecodeActionInvoke(activity.Value)
+	if err != nil {
+		writeJSON(w, http.StatusOK, adaptivecard.NewErrorResponse(http.StatusBadRequest, "BadRequest", err.Error()))
+		return
+	}
+
+	r.mu.RLock()
+	fn, ok := r.handlers[value.Action.Verb]
+	logger := r.logger
+	r.mu.RUnlock()
+	if !ok {
+		writeJSON(w, http.StatusOK, adaptivecard.NewErrorResponse(http.StatusBadRequest, "NotSupported",
+			fmt.Sprintf("verb %q is not supported", value.Action.Verb)))
+		return
+	}
+
+	resp, err := fn(req.Context(), ActionRequest{Activity: activity, Value: value})
+	if err != nil {
+		if logger != nil {
+			logger.ErrorContext(req.Context(), "adaptive card action failed",
+				slog.String("verb", value.Action.Verb), slog.Any("error", err))
+		}
+		resp = adaptivecard.NewErrorResponse(http.StatusInternalServerError, "InternalError",
+			"the action could not be completed")
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
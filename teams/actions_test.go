@@ -0,0 +1,61 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+const approveInvoke = `{"type":"invoke","name":"adaptiveCard/action","value":{"action":{"type":"Action.Execute","verb":"approve"}}}`
+
+func TestActionRouterLogsHandlerErrors(t *testing.T) {
+	r := NewActionRouter()
+	r.Handle("approve", func(context.Context, ActionRequest) (adaptivecard.ActionInvokeResponse, error) {
+		return adaptivecard.ActionInvokeResponse{}, errors.New("dial tcp 10.0.0.7:5432: connection refused")
+	})
+	var logs bytes.Buffer
+	r.SetLogger(slog.New(slog.NewTextHandler(&logs, nil)))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(approveInvoke)))
+
+	if strings.Contains(rec.Body.String(), "10.0.0.7") || !strings.Contains(rec.Body.String(), "InternalError") {
+		t.Errorf("response = %q, want a generic InternalError", rec.Body.String())
+	}
+	if !strings.Contains(logs.String(), "10.0.0.7") {
+		t.Errorf("handler error was not logged: %q", logs.String())
+	}
+}
+
+// TestActionRouterSetLoggerWhileServing is meant for go test -race.
+func TestActionRouterSetLoggerWhileServing(t *testing.T) {
+	r := NewActionRouter()
+	r.Handle("approve", func(context.Context, ActionRequest) (adaptivecard.ActionInvokeResponse, error) {
+		return adaptivecard.ActionInvokeResponse{}, errors.New("failed")
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			r.SetLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(approveInvoke)))
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,345 @@
+// Package teams delivers Adaptive Cards to Microsoft Teams incoming webhooks
+// and serves the HTTP endpoints Teams calls back into.
+package teams
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// DefaultParallelism is the number of concurrent sends PostAll uses unless
+// WithParallelism says otherwise.
+const DefaultParallelism = 4
+
+// Client posts cards to Teams incoming webhook URLs, rate limited per URL
+// (see DefaultRateLimit). It is safe for concurrent use.
+type Client struct {
+	webhookURL  string
+	envelope    Envelope
+	httpClient  *http.Client
+	parallelism int
+	limiter     *limiter
+	maxRetries  int
+	observers   []Observer
+	dedupe      *Dedupe
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithParallelism bounds the number of concurrent sends in PostAll.
+func WithParallelism(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.parallelism = n
+		}
+	}
+}
+
+// WithWebhookURL sets the incoming webhook that Send posts to.
+func WithWebhookURL(url string) Option {
+	return func(c *Client) {
+		c.webhookURL = url
+	}
+}
+
+// WithHTTPClient sends requests through hc instead of http.DefaultClient,
+// e.g. one with a timeout, a corporate proxy or an instrumented transport.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		if hc != nil {
+			c.httpClient = hc
+		}
+	}
+}
+
+// WithTransport sends requests through rt, keeping the other settings of
+// the current HTTP client. Use it for a custom CA or client certificates:
+//
+//	tr := http.DefaultTransport.(*http.Transport).Clone()
+//	tr.TLSClientConfig = &tls.Config{RootCAs: pool}
+//	client := teams.NewClient(teams.WithTransport(tr))
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		hc := *c.httpClient
+		hc.Transport = rt
+		c.httpClient = &hc
+	}
+}
+
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient:  http.DefaultClient,
+		parallelism: DefaultParallelism,
+		limiter:     newLimiter(DefaultRateLimit),
+		maxRetries:  DefaultMaxRetries,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ErrNoWebhookURL is returned by Send on a client without WithWebhookURL.
+var ErrNoWebhookURL = errors.New("teams: no webhook URL configured")
+
+// Failure classes matched by *StatusError with errors.Is.
+var (
+	// ErrBadPayload: Teams could not parse the message or card (400).
+	ErrBadPayload = errors.New("teams: bad payload")
+	// ErrUnauthorized: the webhook refused the request (401, 403).
+	ErrUnauthorized = errors.New("teams: unauthorized")
+	// ErrWebhookNotFound: the webhook was removed or the URL is wrong (404,
+	// 410). Retrying will not help; drop the subscription.
+	ErrWebhookNotFound = errors.New("teams: webhook not found")
+	// ErrPayloadTooLarge: the message exceeds the ~28 KB limit (413).
+	ErrPayloadTooLarge = errors.New("teams: payload too large")
+	// ErrThrottled: Teams rejected the message for rate limiting (429).
+	ErrThrottled = errors.New("teams: throttled")
+)
+
+// StatusError is returned when a webhook answers with a non-2xx status, or
+// with a 200 whose body reports a failed delivery (which connectors do for
+// errors from the Teams backend). Body holds the (truncated) response, which
+// Teams uses for the failure reason.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("teams: webhook returned %d: %s", e.StatusCode, e.Body)
+}
+
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrBadPayload:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrWebhookNotFound:
+		return e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone
+	case ErrPayloadTooLarge:
+		return e.StatusCode == http.StatusRequestEntityTooLarge
+	case ErrThrottled:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// Send posts card to the client's webhook URL (see WithWebhookURL), wrapped
+// in a message activity with an application/vnd.microsoft.card.adaptive
+// attachment. Errors from Teams are *StatusError values; classify them with
+// errors.Is and ErrBadPayload, ErrThrottled and friends.
+func (c *Client) Send(ctx context.Context, card adaptivecard.AdaptiveCard) error {
+	if c.webhookURL == "" {
+		return ErrNoWebhookURL
+	}
+	return c.Post(ctx, c.webhookURL, card)
+}
+
+// Post sends card to a single webhook URL.
+func (c *Client) Post(ctx context.Context, url string, card adaptivecard.AdaptiveCard) error {
+	payload, err := encodeAs(c.envelope, card)
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, url, payload)
+}
+
+// Result is the outcome of sending to one target in PostAll.
+type Result struct {
+	Target string
+	Err    error
+}
+
+// PostAll sends card to every target concurrently, with at most the
+// configured parallelism in flight. The card is serialized once. Results are
+// returned in target order; the error joins every failed send, labelled with
+// the target's index and host since webhook URLs embed credentials, and is
+// nil when all succeeded.
+func (c *Client) PostAll(ctx context.Context, targets []string, card adaptivecard.AdaptiveCard) ([]Result, error) {
+	payload, err := encodeAs(c.envelope, card)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(targets))
+	sem := make(chan struct{}, c.parallelism)
+	done := make(chan struct{})
+	for i, target := range targets {
+		results[i].Target = target
+		go func() {
+			defer func() { done <- struct{}{} }()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			results[i].Err = c.send(ctx, target, payload)
+		}()
+	}
+	for range targets {
+		<-done
+	}
+
+	var errs []error
+	for i, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("target %d (%s): %w", i, hostOf(r.Target), r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func encode(card adaptivecard.AdaptiveCard) ([]byte, error) {
+	return encodeAs(EnvelopeMessage, card)
+}
+
+const maxErrorBody = 512
+
+func (c *Client) send(ctx context.Context, url string, payload []byte) error {
+	if c.dedupe == nil {
+		return c.deliver(ctx, url, payload)
+	}
+
+	key := dedupeKey(url, payload)
+	seen, err := c.dedupe.Store.Mark(ctx, key, c.dedupe.TTL)
+	if err != nil {
+		return fmt.Errorf("teams: dedupe store: %w", err)
+	}
+	if seen {
+		if c.dedupe.Flag {
+			return &DuplicateError{Key: key}
+		}
+		return nil
+	}
+	if err := c.deliver(ctx, url, payload); err != nil {
+		// Use a fresh context: ctx may be the reason delivery failed.
+		_ = c.dedupe.Store.Forget(context.WithoutCancel(ctx), key)
+		return err
+	}
+	return nil
+}
+
+// deliver sends payload, retrying transient failures.
+func (c *Client) deliver(ctx context.Context, url string, payload []byte) error {
+	host := hostOf(url)
+	for attempt := 1; ; attempt++ {
+		if err := c.limiter.wait(ctx, url); err != nil {
+			c.notify(Observer.OnFailure, SendEvent{URL: url, Host: host, Attempt: attempt, PayloadSize: len(payload), Err: err})
+			return err
+		}
+
+		start := time.Now()
+		status, retryAfter, err := c.do(ctx, url, payload)
+		event := SendEvent{
+			URL:         url,
+			Host:        host,
+			Attempt:     attempt,
+			StatusCode:  status,
+			PayloadSize: len(payload),
+			Latency:     time.Since(start),
+			Err:         err,
+		}
+		c.notify(Observer.OnSend, event)
+		if err == nil {
+			return nil
+		}
+		if attempt > c.maxRetries || !retryable(ctx, status, err) {
+			c.notify(Observer.OnFailure, event)
+			return err
+		}
+
+		event.RetryIn = backoff(attempt, retryAfter)
+		c.notify(Observer.OnRetry, event)
+		timer := time.NewTimer(event.RetryIn)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			event.Err = ctx.Err()
+			c.notify(Observer.OnFailure, event)
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *Client) notify(hook func(Observer, SendEvent), event SendEvent) {
+	for _, o := range c.observers {
+		hook(o, event)
+	}
+}
+
+// do makes a single POST, returning the status code and any Retry-After.
+// Errors never quote the webhook URL.
+func (c *Client) do(ctx context.Context, url string, payload []byte) (int, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, 0, &InvalidRequestError{Err: redactURLError(err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, 0, redactURLError(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	body = bytes.TrimSpace(body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, parseRetryAfter(resp.Header.Get("Retry-After")),
+			&StatusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	if status, failed := deliveryFailure(body); failed {
+		return status, 0, &StatusError{StatusCode: status, Body: string(body)}
+	}
+	return resp.StatusCode, 0, nil
+}
+
+// InvalidRequestError reports a request that could not be built, e.g. for a
+// malformed webhook URL. It is not retried.
+type InvalidRequestError struct {
+	Err error
+}
+
+func (e *InvalidRequestError) Error() string {
+	return "teams: invalid request: " + e.Err.Error()
+}
+
+func (e *InvalidRequestError) Unwrap() error {
+	return e.Err
+}
+
+// Connectors answer 200 with a body like "Webhook message delivery failed
+// with error: Microsoft Teams endpoint returned HTTP error 429 ..." when the
+// Teams backend rejected the message.
+var (
+	deliveryFailedPrefix = []byte("Webhook message delivery failed")
+	backendStatusRE      = regexp.MustCompile(`HTTP error (\d{3})`)
+)
+
+// deliveryFailure extracts the backend status of a failed delivery reported
+// in a 200 body, or 502 when it is not given.
+func deliveryFailure(body []byte) (int, bool) {
+	if !bytes.HasPrefix(body, deliveryFailedPrefix) {
+		return 0, false
+	}
+	if m := backendStatusRE.FindSubmatch(body); m != nil {
+		status, _ := strconv.Atoi(string(m[1]))
+		return status, true
+	}
+	return http.StatusBadGateway, true
+}
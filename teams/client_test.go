@@ -0,0 +1,81 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+func TestPostAllErrorsDoNotLeakURLs(t *testing.T) {
+	client := NewClient(
+		WithMaxRetries(0),
+		WithRateLimit(RateLimit{}),
+		WithTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})),
+	)
+	targets := []string{secretWebhook, secretWebhook + "/second"}
+	results, err := client.PostAll(context.Background(), targets, adaptivecard.NewCardBuilder("1.5").Build())
+	if err == nil {
+		t.Fatal("PostAll succeeded, want errors")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks a webhook URL: %v", err)
+	}
+	for _, want := range []string{"target 0 (example.webhook.office.com)", "target 1 (example.webhook.office.com)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+	if results[1].Target != targets[1] {
+		t.Errorf("Result.Target = %q, want the full URL for the caller", results[1].Target)
+	}
+}
+
+func TestInvalidRequestIsNotRetried(t *testing.T) {
+	var calls atomic.Int32
+	var attempts int
+	client := NewClient(
+		WithRateLimit(RateLimit{}),
+		WithObserver(ObserverFuncs{Send: func(SendEvent) { attempts++ }}),
+		WithTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return nil, errors.New("unreachable")
+		})),
+	)
+	err := client.Post(context.Background(), "://secret-token", adaptivecard.NewCardBuilder("1.5").Build())
+	var invalid *InvalidRequestError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("err = %v, want *InvalidRequestError", err)
+	}
+	if attempts != 1 || calls.Load() != 0 {
+		t.Errorf("attempts = %d, transport calls = %d; want 1 and 0", attempts, calls.Load())
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks the webhook URL: %v", err)
+	}
+}
+
+func TestLimiterDropsIdleBuckets(t *testing.T) {
+	l := newLimiter(RateLimit{PerSecond: 1000, Burst: 1})
+	ctx := context.Background()
+	for _, url := range []string{"https://a.example", "https://b.example"} {
+		if err := l.wait(ctx, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	l.lastSweep = time.Time{}
+	if err := l.wait(ctx, "https://c.example"); err != nil {
+		t.Fatal(err)
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("limiter keeps %d buckets, want only the one in use", len(l.buckets))
+	}
+}
@@ -0,0 +1,109 @@
+package teams
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// SeenStore remembers dedupe keys for WithDedupe. Implementations backed by
+// Redis or a database let several processes share the same window.
+type SeenStore interface {
+	// Mark records key for ttl and reports whether it was already recorded
+	// and not yet expired. It must be atomic.
+	Mark(ctx context.Context, key string, ttl time.Duration) (seen bool, err error)
+	// Forget removes key, so a send that failed can be tried again.
+	Forget(ctx context.Context, key string) error
+}
+
+// Dedupe configures idempotent delivery: a card already sent to the same
+// target within TTL is not sent again. The repeat send returns nil, or a
+// *DuplicateError when Flag is set.
+type Dedupe struct {
+	Store SeenStore
+	TTL   time.Duration
+	Flag  bool
+}
+
+// WithDedupe enables idempotent delivery.
+func WithDedupe(d Dedupe) Option {
+	return func(c *Client) {
+		if d.Store != nil && d.TTL > 0 {
+			c.dedupe = &d
+		}
+	}
+}
+
+// ErrDuplicate is matched by every *DuplicateError.
+var ErrDuplicate = errors.New("teams: duplicate send suppressed")
+
+// DuplicateError reports a send skipped because the same card went to the
+// same target within the dedupe TTL.
+type DuplicateError struct {
+	Key string
+}
+
+func (e *DuplicateError) Error() string {
+	return "teams: duplicate send suppressed (key " + e.Key + ")"
+}
+
+func (e *DuplicateError) Is(target error) bool {
+	return target == ErrDuplicate
+}
+
+// DedupeKey returns the key WithDedupe uses for sending card to url with
+// EnvelopeMessage: a SHA-256 of the target and the serialized message.
+func DedupeKey(url string, card adaptivecard.AdaptiveCard) (string, error) {
+	payload, err := encode(card)
+	if err != nil {
+		return "", err
+	}
+	return dedupeKey(url, payload), nil
+}
+
+func dedupeKey(url string, payload []byte) string {
+	h := sha256.New()
+	h.Write([]byte(url))
+	h.Write([]byte{0})
+	h.Write(payload)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// MemorySeenStore is an in-process SeenStore.
+type MemorySeenStore struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+func NewMemorySeenStore() *MemorySeenStore {
+	return &MemorySeenStore{expires: map[string]time.Time{}}
+}
+
+func (s *MemorySeenStore) Mark(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, exp := range s.expires {
+		if !now.Before(exp) {
+			delete(s.expires, k)
+		}
+	}
+	if _, ok := s.expires[key]; ok {
+		return true, nil
+	}
+	s.expires[key] = now.Add(ttl)
+	return false, nil
+}
+
+func (s *MemorySeenStore) Forget(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.expires, key)
+	return nil
+}
@@ -0,0 +1,138 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// SendEvent describes one delivery attempt.
+type SendEvent struct {
+	// URL is the webhook URL. It embeds the webhook's credentials; export
+	// Host instead when labelling metrics or logs.
+	URL         string
+	Host        string
+	Attempt     int
+	StatusCode  int
+	PayloadSize int
+	Latency     time.Duration
+	Err         error
+	// RetryIn is the wait before the next attempt; only set for OnRetry.
+	RetryIn time.Duration
+}
+
+// Observer receives delivery events, e.g. to export metrics. OnSend is called
+// after every HTTP attempt, successful or not; OnRetry when a failed attempt
+// will be retried; OnFailure when a send gives up. Methods are called
+// synchronously from the sending goroutine and must not block.
+type Observer interface {
+	OnSend(SendEvent)
+	OnRetry(SendEvent)
+	OnFailure(SendEvent)
+}
+
+// ObserverFuncs adapts plain functions to Observer; nil fields are skipped.
+type ObserverFuncs struct {
+	Send    func(SendEvent)
+	Retry   func(SendEvent)
+	Failure func(SendEvent)
+}
+
+func (o ObserverFuncs) OnSend(e SendEvent) {
+	if o.Send != nil {
+		o.Send(e)
+	}
+}
+
+func (o ObserverFuncs) OnRetry(e SendEvent) {
+	if o.Retry != nil {
+		o.Retry(e)
+	}
+}
+
+func (o ObserverFuncs) OnFailure(e SendEvent) {
+	if o.Failure != nil {
+		o.Failure(e)
+	}
+}
+
+// WithObserver adds an observer; it may be given more than once.
+func WithObserver(o Observer) Option {
+	return func(c *Client) {
+		c.observers = append(c.observers, o)
+	}
+}
+
+// WithLogger logs attempts at debug level, retries at warn and failures at
+// error level. Only the webhook host is logged.
+func WithLogger(logger *slog.Logger) Option {
+	return WithObserver(slogObserver{logger})
+}
+
+type slogObserver struct {
+	logger *slog.Logger
+}
+
+func (o slogObserver) attrs(e SendEvent) []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("host", e.Host),
+		slog.Int("attempt", e.Attempt),
+		slog.Int("status", e.StatusCode),
+		slog.Int("payload_bytes", e.PayloadSize),
+		slog.Duration("latency", e.Latency),
+	}
+	if e.Err != nil {
+		attrs = append(attrs, errorAttrs(e.Err)...)
+	}
+	if e.RetryIn > 0 {
+		attrs = append(attrs, slog.Duration("retry_in", e.RetryIn))
+	}
+	return attrs
+}
+
+func (o slogObserver) OnSend(e SendEvent) {
+	o.logger.LogAttrs(context.Background(), slog.LevelDebug, "teams webhook send", o.attrs(e)...)
+}
+
+func (o slogObserver) OnRetry(e SendEvent) {
+	o.logger.LogAttrs(context.Background(), slog.LevelWarn, "teams webhook retry", o.attrs(e)...)
+}
+
+func (o slogObserver) OnFailure(e SendEvent) {
+	o.logger.LogAttrs(context.Background(), slog.LevelError, "teams webhook failed", o.attrs(e)...)
+}
+
+// errorAttrs describes err for the log. The *url.Error returned by
+// http.Client quotes the full webhook URL, so only its Op and the error it
+// wraps are logged; the host is logged separately.
+func errorAttrs(err error) []slog.Attr {
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		return []slog.Attr{slog.String("op", uerr.Op), slog.String("error", uerr.Err.Error())}
+	}
+	return []slog.Attr{slog.Any("error", err)}
+}
+
+// redactURLError replaces the webhook URL quoted by a *url.Error with its
+// scheme and host.
+func redactURLError(err error) error {
+	var uerr *url.Error
+	if !errors.As(err, &uerr) {
+		return err
+	}
+	redacted := "[redacted]"
+	if u, perr := url.Parse(uerr.URL); perr == nil && u.Host != "" {
+		redacted = u.Scheme + "://" + u.Host + "/[redacted]"
+	}
+	return &url.Error{Op: uerr.Op, URL: redacted, Err: uerr.Err}
+}
+
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
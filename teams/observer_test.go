@@ -0,0 +1,45 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+const secretWebhook = "https://example.webhook.office.com/webhookb2/secret-token/IncomingWebhook/abc/def"
+
+func TestLoggerDoesNotLeakWebhookURL(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient(
+		WithLogger(logger),
+		WithMaxRetries(0),
+		WithRateLimit(RateLimit{}),
+		WithTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})),
+	)
+	err := client.Post(context.Background(), secretWebhook, adaptivecard.NewCardBuilder("1.5").Build())
+	if err == nil {
+		t.Fatal("Post succeeded, want a transport error")
+	}
+	out := buf.String()
+	if strings.Contains(out, "secret-token") {
+		t.Errorf("log leaks the webhook URL:\n%s", out)
+	}
+	for _, want := range []string{"host=example.webhook.office.com", "op=Post", `error="connection refused"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log does not contain %s:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package teams
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+const maxOutgoingBody = 1 << 20
+
+// OutgoingMessage is the message Teams posts to an outgoing webhook when the
+// webhook is @mentioned.
+type OutgoingMessage struct {
+	Type         string                           `json:"type"`
+	ID           string                           `json:"id"`
+	Text         string                           `json:"text"`
+	TextFormat   string                           `json:"textFormat,omitempty"`
+	ServiceURL   string                           `json:"serviceUrl,omitempty"`
+	From         adaptivecard.ChannelAccount      `json:"from"`
+	Conversation adaptivecard.ConversationAccount `json:"conversation"`
+	ChannelData  json.RawMessage                  `json:"channelData,omitempty"`
+}
+
+// OutgoingHandlerFunc answers an outgoing webhook message with a card.
+type OutgoingHandlerFunc func(ctx context.Context, msg OutgoingMessage) (adaptivecard.AdaptiveCard, error)
+
+type outgoingHandler struct {
+	key []byte
+	fn  OutgoingHandlerFunc
+}
+
+// NewOutgoingWebhookHandler returns a handler for a Teams outgoing webhook.
+// secret is the base64 security token shown when the webhook was created;
+// requests whose HMAC signature does not match it are rejected with 401.
+// The card returned by fn is sent back as the reply. An error is logged with
+// the default slog logger and answered with a generic 500, so its text does
+// not reach Teams.
+func NewOutgoingWebhookHandler(secret string, fn OutgoingHandlerFunc) (http.Handler, error) {
+	key, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("teams: decoding outgoing webhook secret: %w", err)
+	}
+	return &outgoingHandler{key: key, fn: fn}, nil
+}
+
+func (h *outgoingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxOutgoingBody))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+	if !h.verify(r.Header.Get("Authorization"), body) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var msg OutgoingMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		http.Error(w, "invalid message", http.StatusBadRequest)
+		return
+	}
+
+	card, err := h.fn(r.Context(), msg)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "outgoing webhook handler failed", slog.Any("error", err))
+		http.Error(w, "the message could not be answered", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, adaptivecard.NewMessageActivity(card))
+}
+
+// verify checks the "HMAC <base64 signature>" Authorization header against
+// an HMAC-SHA256 of the body.
+func (h *outgoingHandler) verify(auth string, body []byte) bool {
+	sig, ok := strings.CutPrefix(auth, "HMAC ")
+	if !ok {
+		return false
+	}
+	got, err := base64.StdEncoding.DecodeString(strings.TrimSpace(sig))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(payload)
+}
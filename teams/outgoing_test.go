@@ -0,0 +1,51 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+func TestOutgoingWebhookHandlerHidesHandlerErrors(t *testing.T) {
+	var logs bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	key := []byte("outgoing-secret")
+	h, err := NewOutgoingWebhookHandler(base64.StdEncoding.EncodeToString(key),
+		func(context.Context, OutgoingMessage) (adaptivecard.AdaptiveCard, error) {
+			return adaptivecard.AdaptiveCard{}, errors.New("dial tcp 10.0.0.7:5432: connection refused")
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(`{"type":"message","text":"<at>Bot</at> status"}`)
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	req.Header.Set("Authorization", "HMAC "+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "10.0.0.7") {
+		t.Errorf("response leaks the handler error: %q", rec.Body.String())
+	}
+	if !strings.Contains(logs.String(), "10.0.0.7") {
+		t.Errorf("handler error was not logged: %q", logs.String())
+	}
+}
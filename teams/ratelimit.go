@@ -0,0 +1,136 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit matches the Teams connector limit of about four requests
+// per second per webhook.
+var DefaultRateLimit = RateLimit{PerSecond: 4, Burst: 4}
+
+// RateLimit configures the per-webhook-URL limiter. Sends over the limit wait
+// for a slot unless Reject is set, in which case they fail with a
+// *RateLimitError.
+type RateLimit struct {
+	PerSecond float64
+	Burst     int
+	Reject    bool
+}
+
+// WithRateLimit replaces DefaultRateLimit. A zero PerSecond disables
+// client-side limiting.
+func WithRateLimit(limit RateLimit) Option {
+	return func(c *Client) {
+		c.limiter = newLimiter(limit)
+	}
+}
+
+// ErrRateLimited is matched by every *RateLimitError.
+var ErrRateLimited = errors.New("teams: rate limited")
+
+// RateLimitError reports a send rejected by the client-side limiter.
+type RateLimitError struct {
+	// URL is the webhook that was limited. Webhook URLs embed their
+	// credentials, so it is left out of Error().
+	URL string
+	// RetryAfter is how long until the URL has capacity again.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("teams: rate limit exceeded for webhook, retry after %s", e.RetryAfter)
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// sweepInterval is how often the limiter drops the buckets of idle URLs.
+const sweepInterval = time.Minute
+
+// limiter is a token bucket per webhook URL. Buckets that have refilled
+// completely are dropped, since a new bucket behaves the same, so clients
+// posting to many URLs over time do not grow without bound.
+type limiter struct {
+	limit RateLimit
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	if limit.PerSecond <= 0 {
+		return nil
+	}
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &limiter{limit: limit, buckets: map[string]*bucket{}}
+}
+
+// wait blocks until url may be sent to, or fails straight away in reject
+// mode. A nil limiter never blocks.
+func (l *limiter) wait(ctx context.Context, url string) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[url]
+	if !ok {
+		b = &bucket{tokens: float64(l.limit.Burst), last: now}
+		l.buckets[url] = b
+	}
+	b.tokens = min(b.tokens+now.Sub(b.last).Seconds()*l.limit.PerSecond, float64(l.limit.Burst))
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		l.mu.Unlock()
+		return nil
+	}
+	delay := time.Duration((1 - b.tokens) / l.limit.PerSecond * float64(time.Second))
+	if l.limit.Reject {
+		l.mu.Unlock()
+		return &RateLimitError{URL: url, RetryAfter: delay}
+	}
+	// Reserve the next slot so queued sends go out in order.
+	b.tokens--
+	l.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// sweep drops the buckets that are full again at now. l.mu must be held.
+func (l *limiter) sweep(now time.Time) {
+	for url, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.limit.PerSecond >= float64(l.limit.Burst) {
+			delete(l.buckets, url)
+		}
+	}
+	l.lastSweep = now
+}
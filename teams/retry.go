@@ -0,0 +1,67 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxRetries is the number of times a send is retried after a 429,
+// a 5xx or a network error.
+const DefaultMaxRetries = 2
+
+const (
+	baseBackoff = 500 * time.Millisecond
+	maxBackoff  = 30 * time.Second
+)
+
+// WithMaxRetries sets how many times a failed send is retried; 0 disables
+// retries.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		if n >= 0 {
+			c.maxRetries = n
+		}
+	}
+}
+
+// retryable reports whether an attempt that ended with status (0 for network
+// errors) and err is worth repeating.
+func retryable(ctx context.Context, status int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var invalid *InvalidRequestError
+	if errors.As(err, &invalid) {
+		return false
+	}
+	return status == 0 || status == http.StatusTooManyRequests || status >= 500
+}
+
+// backoff doubles from baseBackoff per attempt, but a server-provided
+// Retry-After takes precedence. Both are capped at maxBackoff, which also
+// covers shifts that overflow once WithMaxRetries allows many attempts.
+func backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, maxBackoff)
+	}
+	if d := baseBackoff << (attempt - 1); d > 0 && d < maxBackoff {
+		return d
+	}
+	return maxBackoff
+}
+
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		status int
+		err    error
+		want   bool
+	}{
+		{"network error", context.Background(), 0, errors.New("connection reset"), true},
+		{"too many requests", context.Background(), http.StatusTooManyRequests, nil, true},
+		{"server error", context.Background(), http.StatusBadGateway, nil, true},
+		{"bad request", context.Background(), http.StatusBadRequest, nil, false},
+		{"not found", context.Background(), http.StatusNotFound, nil, false},
+		{"invalid request", context.Background(), 0, &InvalidRequestError{Err: errors.New("bad URL")}, false},
+		{"canceled context", canceled, http.StatusServiceUnavailable, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.ctx, tt.status, tt.err); got != tt.want {
+				t.Errorf("retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt    int
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{1, 0, baseBackoff},
+		{2, 0, 2 * baseBackoff},
+		{3, 0, 4 * baseBackoff},
+		{10, 0, maxBackoff},
+		{64, 0, maxBackoff},
+		{1, 3 * time.Second, 3 * time.Second},
+		{1, time.Hour, maxBackoff},
+		{3, -time.Second, 4 * baseBackoff},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempt, tt.retryAfter); got != tt.want {
+			t.Errorf("backoff(%d, %v) = %v, want %v", tt.attempt, tt.retryAfter, got, tt.want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		min, max time.Duration
+	}{
+		{"empty", "", 0, 0},
+		{"seconds", "7", 7 * time.Second, 7 * time.Second},
+		{"garbage", "soon", 0, 0},
+		{"http date", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %v, want between %v and %v", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package teams
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard"
+)
+
+// Envelope selects the request body a Client posts.
+type Envelope int
+
+const (
+	// EnvelopeMessage wraps the card in a message with one card attachment
+	// (contentUrl null). O365 connector webhooks and the Workflows template
+	// "Post to a channel when a webhook request is received" both accept it.
+	EnvelopeMessage Envelope = iota
+	// EnvelopeCard posts the bare card, for Workflows whose "Post card in a
+	// chat or channel" step takes the trigger body as the card itself.
+	EnvelopeCard
+)
+
+// WithEnvelope sets the body format; the default is EnvelopeMessage.
+func WithEnvelope(e Envelope) Option {
+	return func(c *Client) {
+		c.envelope = e
+	}
+}
+
+// IsWorkflowURL reports whether rawURL is a Power Automate Workflows trigger
+// rather than an O365 connector webhook. Workflows answer 202 with an empty
+// body once the flow run has been queued, so a success only means the
+// payload was accepted; failures of the posting step show in the flow's run
+// history.
+func IsWorkflowURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return strings.HasSuffix(host, ".logic.azure.com") ||
+		strings.HasSuffix(host, ".api.powerplatform.com") ||
+		strings.Contains(u.Path, "/workflows/") && strings.Contains(u.Path, "/triggers/")
+}
+
+func encodeAs(e Envelope, card adaptivecard.AdaptiveCard) ([]byte, error) {
+	var v any
+	switch e {
+	case EnvelopeMessage:
+		v = adaptivecard.NewMessageActivity(card)
+	case EnvelopeCard:
+		if card.Type == "" {
+			card.Type = "AdaptiveCard"
+		}
+		v = card
+	default:
+		return nil, fmt.Errorf("teams: unknown envelope %d", e)
+	}
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("teams: encoding card: %w", err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,105 @@
+package adaptivecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// TeamsAction is the "msteams" object Teams looks for in the data of an
+// Action.Submit to run a Teams-specific behavior instead of a plain submit.
+type TeamsAction struct {
+	// Type is "invoke", "task/fetch", "messageBack" or "imBack".
+	Type string `json:"type"`
+	// DisplayText is posted in the chat as the user's message (messageBack).
+	DisplayText string `json:"displayText,omitempty"`
+	// Text is sent to the bot but not shown (messageBack).
+	Text  string `json:"text,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// TeamsActionData is the data of an Action.Submit built by the Teams action
+// constructors: MSTeams followed by the members of Data, which must marshal
+// to a JSON object (or be nil).
+type TeamsActionData struct {
+	MSTeams TeamsAction
+	Data    any
+}
+
+func (d TeamsActionData) MarshalJSON() ([]byte, error) {
+	teams, err := json.Marshal(d.MSTeams)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(`{"msteams":`)
+	buf.Write(teams)
+	if d.Data != nil {
+		data, err := json.Marshal(d.Data)
+		if err != nil {
+			return nil, err
+		}
+		data = bytes.TrimSpace(data)
+		if len(data) < 2 || data[0] != '{' {
+			return nil, errors.New("adaptivecard: data of a Teams action must be a JSON object")
+		}
+		if members := bytes.TrimSpace(data[1 : len(data)-1]); len(members) > 0 {
+			buf.WriteByte(',')
+			buf.Write(members)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// TabInfo is the tab NewStageViewAction opens in stage view.
+type TabInfo struct {
+	ContentURL string `json:"contentUrl"`
+	WebsiteURL string `json:"websiteUrl,omitempty"`
+	Name       string `json:"name,omitempty"`
+	EntityID   string `json:"entityId"`
+}
+
+// NewTaskFetchAction returns an Action.Submit that opens a task module
+// (dialog): Teams sends the bot a task/fetch invoke with data, and the bot
+// answers with NewTaskContinue.
+func NewTaskFetchAction(title string, data any) SubmitAction {
+	return NewSubmitAction(title, TeamsActionData{
+		MSTeams: TeamsAction{Type: "task/fetch"},
+		Data:    data,
+	})
+}
+
+// NewInvokeAction returns an Action.Submit that sends the bot an invoke
+// activity carrying value, rather than a message.
+func NewInvokeAction(title string, value any) SubmitAction {
+	return NewSubmitAction(title, TeamsActionData{
+		MSTeams: TeamsAction{Type: "invoke", Value: value},
+	})
+}
+
+// NewStageViewAction returns an Action.Submit that opens tab full-screen in
+// stage view, e.g. a dashboard linked from an alert.
+func NewStageViewAction(title string, tab TabInfo) SubmitAction {
+	return NewInvokeAction(title, struct {
+		Type    string  `json:"type"`
+		TabInfo TabInfo `json:"tabInfo"`
+	}{"tab/tabInfoAction", tab})
+}
+
+// NewMessageBackAction returns an Action.Submit that sends the bot a message
+// activity with text and value, and posts displayText in the chat as if the
+// user had typed it; displayText may be empty to post nothing.
+func NewMessageBackAction(title, displayText, text string, value any) SubmitAction {
+	return NewSubmitAction(title, TeamsActionData{
+		MSTeams: TeamsAction{Type: "messageBack", DisplayText: displayText, Text: text, Value: value},
+	})
+}
+
+// NewIMBackAction returns an Action.Submit that posts text in the chat as the
+// user's message, which the bot receives like any other message.
+func NewIMBackAction(title, text string) SubmitAction {
+	return NewSubmitAction(title, TeamsActionData{
+		MSTeams: TeamsAction{Type: "imBack", Value: text},
+	})
+}
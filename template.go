@@ -0,0 +1,273 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard/internal/expr"
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// Expand renders an Adaptive Card template (the Template Language used by
+// the Designer) against data and parses the result:
+//
+//   - "${expr}" bindings in strings are evaluated against the current data
+//     context. A string that is a single binding takes the value's type, so
+//     "${count}" becomes a number; otherwise values are interpolated.
+//   - "$data" on an object changes its data context. If it evaluates to an
+//     array the object is repeated once per item, with "$index" set.
+//   - "$when" drops the object unless it evaluates to true.
+//   - "$root" is the top-level data; "\${" is a literal "${".
+//
+// Expressions support property paths, indexing, the usual operators and
+// common built-in functions such as if, concat, formatNumber, count, join
+// and toUpper. data can be any value encoding/json can marshal; a binding
+// whose value is missing is left in place, as the official SDKs do.
+func Expand(templateJSON []byte, data any) (AdaptiveCard, error) {
+	tmpl, err := orderedjson.Parse(templateJSON)
+	if err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: template: %w", err)
+	}
+	root, err := plainValue(data)
+	if err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: template data: %w", err)
+	}
+	out, err := expandValue(tmpl, newScope(root, root, nil), "$")
+	if err != nil {
+		return AdaptiveCard{}, err
+	}
+	if len(out) != 1 {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: template: root must expand to one card, got %d", len(out))
+	}
+	card, err := orderedjson.Marshal(out[0])
+	if err != nil {
+		return AdaptiveCard{}, fmt.Errorf("adaptivecard: template: %w", err)
+	}
+	return ParseCard(card)
+}
+
+func newScope(root, data, index any) expr.Env {
+	return expr.Env{
+		Data: data,
+		Vars: map[string]any{"$root": root, "$data": data, "$index": index},
+	}
+}
+
+// expandValue expands one template value. It returns no values when $when
+// drops an object and several when $data repeats it.
+func expandValue(v any, scope expr.Env, path string) ([]any, error) {
+	switch v := v.(type) {
+	case *orderedjson.Object:
+		return expandObject(v, scope, path)
+	case []any:
+		out := []any{}
+		for i, item := range v {
+			expanded, err := expandValue(item, scope, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, expanded...)
+		}
+		return []any{out}, nil
+	case string:
+		s, err := expandString(v, scope, path)
+		if err != nil {
+			return nil, err
+		}
+		return []any{s}, nil
+	}
+	return []any{v}, nil
+}
+
+func expandObject(obj *orderedjson.Object, scope expr.Env, path string) ([]any, error) {
+	dataTmpl, ok := obj.Get("$data")
+	if !ok {
+		return expandMembers(obj, scope, path)
+	}
+	expanded, err := expandValue(dataTmpl, scope, path+".$data")
+	if err != nil {
+		return nil, err
+	}
+	if len(expanded) != 1 {
+		return nil, fmt.Errorf("adaptivecard: template: %s.$data: must expand to one value", path)
+	}
+	data, err := plainValue(expanded[0])
+	if err != nil {
+		return nil, fmt.Errorf("adaptivecard: template: %s.$data: %w", path, err)
+	}
+	root := scope.Vars["$root"]
+	items, repeat := data.([]any)
+	if !repeat {
+		return expandMembers(obj, newScope(root, data, scope.Vars["$index"]), path)
+	}
+	out := []any{}
+	for i, item := range items {
+		expanded, err := expandMembers(obj, newScope(root, item, float64(i)), fmt.Sprintf("%s[$index=%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, expanded...)
+	}
+	return out, nil
+}
+
+// expandMembers expands the members of obj, other than $data, in scope.
+func expandMembers(obj *orderedjson.Object, scope expr.Env, path string) ([]any, error) {
+	if when, ok := obj.Get("$when"); ok {
+		keep, err := evalWhen(when, scope, path+".$when")
+		if err != nil {
+			return nil, err
+		}
+		if !keep {
+			return nil, nil
+		}
+	}
+	out := &orderedjson.Object{}
+	for _, m := range obj.Members {
+		if m.Key == "$data" || m.Key == "$when" {
+			continue
+		}
+		expanded, err := expandValue(m.Value, scope, path+"."+m.Key)
+		if err != nil {
+			return nil, err
+		}
+		switch len(expanded) {
+		case 0:
+		case 1:
+			out.Members = append(out.Members, orderedjson.Member{Key: m.Key, Value: expanded[0]})
+		default:
+			out.Members = append(out.Members, orderedjson.Member{Key: m.Key, Value: expanded})
+		}
+	}
+	return []any{out}, nil
+}
+
+func evalWhen(when any, scope expr.Env, path string) (bool, error) {
+	switch when := when.(type) {
+	case bool:
+		return when, nil
+	case string:
+		v, err := expandString(when, scope, path)
+		if err != nil {
+			return false, err
+		}
+		if s, ok := v.(string); ok && s == when {
+			// The binding did not resolve.
+			return false, nil
+		}
+		return expr.Truthy(v), nil
+	}
+	return false, fmt.Errorf("adaptivecard: template: %s: must be a boolean or a binding", path)
+}
+
+// expandString evaluates the bindings in s. A string that is exactly one
+// binding yields the value itself; unresolved bindings are kept as written.
+func expandString(s string, scope expr.Env, path string) (any, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+	segs, err := splitBindings(s)
+	if err != nil {
+		return nil, fmt.Errorf("adaptivecard: template: %s: %w", path, err)
+	}
+	var sb strings.Builder
+	for _, seg := range segs {
+		if !seg.binding {
+			sb.WriteString(seg.text)
+			continue
+		}
+		v, err := expr.Eval(seg.text, scope)
+		if err != nil {
+			return nil, fmt.Errorf("adaptivecard: template: %s: ${%s}: %w", path, seg.text, err)
+		}
+		if v == nil {
+			sb.WriteString("${" + seg.text + "}")
+			continue
+		}
+		if len(segs) == 1 {
+			return v, nil
+		}
+		sb.WriteString(expr.String(v))
+	}
+	return sb.String(), nil
+}
+
+type segment struct {
+	text    string
+	binding bool
+}
+
+// splitBindings splits s into literal text and the expressions of its
+// ${...} bindings, honouring quotes and nested braces inside expressions.
+func splitBindings(s string) ([]segment, error) {
+	var segs []segment
+	var lit strings.Builder
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], `\${`):
+			lit.WriteString("${")
+			i += 3
+		case strings.HasPrefix(s[i:], "${"):
+			end, err := bindingEnd(s, i+2)
+			if err != nil {
+				return nil, err
+			}
+			if lit.Len() > 0 {
+				segs = append(segs, segment{text: lit.String()})
+				lit.Reset()
+			}
+			segs = append(segs, segment{text: s[i+2 : end], binding: true})
+			i = end + 1
+		default:
+			lit.WriteByte(s[i])
+			i++
+		}
+	}
+	if lit.Len() > 0 {
+		segs = append(segs, segment{text: lit.String()})
+	}
+	return segs, nil
+}
+
+// bindingEnd returns the index of the "}" closing the binding whose
+// expression starts at start.
+func bindingEnd(s string, start int) (int, error) {
+	depth := 0
+	var quote byte
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				return i, nil
+			}
+			depth--
+		}
+	}
+	return 0, fmt.Errorf("unterminated binding %q", s[start-2:])
+}
+
+// plainValue converts v to the generic form expressions work on: nil, bool,
+// float64, string, []any and map[string]any.
+func plainValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
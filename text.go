@@ -0,0 +1,23 @@
+package adaptivecard
+
+import "fmt"
+
+// elementTexts reports the user-visible text held directly by el (not its
+// children) together with the JSON path of each string.
+func elementTexts(path string, el Element, fn func(path, text string)) {
+	switch el := el.(type) {
+	case TextBlock:
+		fn(path+".text", el.Text)
+	case RichTextBlock:
+		for i, run := range el.Inlines {
+			fn(fmt.Sprintf("%s.inlines[%d].text", path, i), run.Text)
+		}
+	case FactSet:
+		for i, f := range el.Facts {
+			fn(fmt.Sprintf("%s.facts[%d].title", path, i), f.Title)
+			fn(fmt.Sprintf("%s.facts[%d].value", path, i), f.Value)
+		}
+	case Badge:
+		fn(path+".text", el.Text)
+	}
+}
@@ -0,0 +1,92 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Finding is one issue reported by a card lint or audit.
+type Finding struct {
+	Path    string `json:"path"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s (%s)", f.Path, f.Message, f.Rule)
+}
+
+// Theme lint rules.
+const (
+	RuleThemeFixedColor = "theme-fixed-color"
+	RuleThemeHexColor   = "theme-hex-color"
+	RuleThemeBackground = "theme-background-image"
+)
+
+// ThemeSafeColor maps a text color to one that stays legible in both the
+// light and dark Teams themes. "dark" and "light" are absolute colors that
+// disappear against one of the two backgrounds, so they become "default";
+// semantic colors (accent, good, warning, attention) are adjusted by the
+// host per theme and are returned unchanged.
+func ThemeSafeColor(color string) string {
+	switch strings.ToLower(color) {
+	case "dark", "light":
+		return "default"
+	}
+	return color
+}
+
+var hexColorRE = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// LintTheme flags choices that only render well in one Teams theme: the
+// absolute "dark"/"light" text colors, hard-coded hex colors, and background
+// images (text contrast on top of them cannot be guaranteed in both themes).
+func (c AdaptiveCard) LintTheme() ([]Finding, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var tree any
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	lintThemeValue("$", "", tree, &findings)
+	return findings, nil
+}
+
+func lintThemeValue(path, key string, v any, findings *[]Finding) {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childPath := path + "." + k
+			if k == "backgroundImage" {
+				*findings = append(*findings, Finding{childPath, RuleThemeBackground,
+					"background images may leave text unreadable in one of the themes"})
+			}
+			lintThemeValue(childPath, k, v[k], findings)
+		}
+	case []any:
+		for i, item := range v {
+			lintThemeValue(fmt.Sprintf("%s[%d]", path, i), key, item, findings)
+		}
+	case string:
+		switch {
+		case key == "color" && ThemeSafeColor(v) != v:
+			*findings = append(*findings, Finding{path, RuleThemeFixedColor,
+				fmt.Sprintf("color %q is invisible in one of the themes; use %q or a semantic color", v, ThemeSafeColor(v))})
+		case hexColorRE.MatchString(v):
+			*findings = append(*findings, Finding{path, RuleThemeHexColor,
+				fmt.Sprintf("hard-coded color %s does not adapt to the theme", v)})
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package adaptivecard
+
+import "slices"
+
+// NewTombstone returns a minimal card to replace original once it no longer
+// needs attention, e.g. "This alert was resolved". Facts in original whose
+// titles are listed in keepFacts (incident IDs, hosts, ...) are carried over
+// so the message can still be correlated; everything else, including
+// actions, refresh and mentions, is dropped.
+func NewTombstone(original AdaptiveCard, message string, keepFacts ...string) AdaptiveCard {
+	card := AdaptiveCard{
+		Type:    "AdaptiveCard",
+		Version: original.Version,
+		Schema:  original.Schema,
+	}
+	if original.MSTeams != nil && original.MSTeams.Width != "" {
+		card.MSTeams = &MSTeamsInfo{Width: original.MSTeams.Width}
+	}
+
+	text := NewTextBlock(message)
+	text.WithSubtle()
+	card.AddBody(text)
+
+	var facts []Fact
+	_ = walk(original.Body, "$.body", func(_ string, el Element) error {
+		if fs, ok := el.(FactSet); ok {
+			for _, f := range fs.Facts {
+				if slices.Contains(keepFacts, f.Title) {
+					facts = append(facts, f)
+				}
+			}
+		}
+		return nil
+	})
+	if len(facts) > 0 {
+		card.AddBody(NewFactSet(facts...))
+	}
+	return card
+}
+
+// NewTombstoneActivity replaces the bot message activityID with
+// NewTombstone(original, message, keepFacts...). Incoming webhooks cannot
+// edit messages; post the tombstone card as a follow-up there instead.
+func NewTombstoneActivity(activityID string, original AdaptiveCard, message string, keepFacts ...string) Activity {
+	return NewUpdateActivity(activityID, NewTombstone(original, message, keepFacts...))
+}
@@ -0,0 +1,144 @@
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// URLPolicy decides whether a URL may appear in a card; it returns an error to
+// reject it.
+type URLPolicy func(u *url.URL) error
+
+// AllowHosts returns a policy that only accepts http(s) URLs on one of hosts or
+// their subdomains. data: URIs are not affected.
+func AllowHosts(hosts ...string) URLPolicy {
+	return func(u *url.URL) error {
+		if u.Scheme == "data" {
+			return nil
+		}
+		for _, h := range hosts {
+			if matchHost(u.Hostname(), h) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host %q is not allowed", u.Hostname())
+	}
+}
+
+// DenyHosts returns a policy that rejects URLs on any of hosts or their
+// subdomains.
+func DenyHosts(hosts ...string) URLPolicy {
+	return func(u *url.URL) error {
+		for _, h := range hosts {
+			if matchHost(u.Hostname(), h) {
+				return fmt.Errorf("host %q is denied", u.Hostname())
+			}
+		}
+		return nil
+	}
+}
+
+func matchHost(host, pattern string) bool {
+	host, pattern = strings.ToLower(host), strings.ToLower(pattern)
+	return host == pattern || strings.HasSuffix(host, "."+pattern)
+}
+
+// ValidateURLs checks every URL in the card — Action.OpenUrl targets, images,
+// media sources, posters and captions — and returns all problems joined in
+// one error. Each URL must be an absolute http, https or data URI and pass
+// every policy.
+func (c AdaptiveCard) ValidateURLs(policies ...URLPolicy) error {
+	var errs []error
+	check := func(path, raw string) {
+		if err := validateURL(raw, policies); err != nil {
+			errs = append(errs, fmt.Errorf("adaptivecard: %s: %w", path, err))
+		}
+	}
+
+	for i, a := range flatActions(c.Actions) {
+		actionURLs(fmt.Sprintf("$.actions[%d]", i), a, check)
+	}
+	if c.SelectAction != nil {
+		actionURLs("$.selectAction", *c.SelectAction, check)
+	}
+	if c.Authentication != nil {
+		for i, b := range c.Authentication.Buttons {
+			if b.Type == "signin" {
+				check(fmt.Sprintf("$.authentication.buttons[%d].value", i), b.Value)
+			}
+		}
+	}
+	walk(c.Body, "$.body", func(path string, el Element) error {
+		elementURLs(path, el, check)
+		return nil
+	})
+	return errors.Join(errs...)
+}
+
+func validateURL(raw string, policies []URLPolicy) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("url %q has no host", raw)
+		}
+	case "data":
+	default:
+		return fmt.Errorf("url %q must be an absolute http(s) or data URI", raw)
+	}
+	for _, p := range policies {
+		if err := p(u); err != nil {
+			return fmt.Errorf("url %q: %w", raw, err)
+		}
+	}
+	return nil
+}
+
+// elementURLs reports the URLs held directly by el (not its children).
+func elementURLs(path string, el Element, fn func(path, raw string)) {
+	switch el := el.(type) {
+	case Image:
+		fn(path+".url", el.URL)
+	case Media:
+		for i, s := range el.Sources {
+			fn(fmt.Sprintf("%s.sources[%d].url", path, i), s.URL)
+		}
+		if el.Poster != "" {
+			fn(path+".poster", el.Poster)
+		}
+		for i, s := range el.CaptionSources {
+			fn(fmt.Sprintf("%s.captionSources[%d].url", path, i), s.URL)
+		}
+	case Container:
+		if el.BackgroundImage != nil {
+			fn(path+".backgroundImage.url", el.BackgroundImage.URL)
+		}
+	case ActionSet:
+		for i, a := range flatActions(el.Actions) {
+			actionURLs(fmt.Sprintf("%s.actions[%d]", path, i), a, fn)
+		}
+	}
+	for _, sel := range selectActions(path, el) {
+		actionURLs(sel.path, sel.action, fn)
+	}
+}
+
+func actionURLs(path string, a Action, fn func(path, raw string)) {
+	if a.Type == "Action.OpenUrl" {
+		fn(path+".url", a.Url)
+	}
+	if a.Card != nil {
+		for i, nested := range flatActions(a.Card.Actions) {
+			actionURLs(fmt.Sprintf("%s.card.actions[%d]", path, i), nested, fn)
+		}
+		walk(a.Card.Body, path+".card.body", func(path string, el Element) error {
+			elementURLs(path, el, fn)
+			return nil
+		})
+	}
+}
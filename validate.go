@@ -0,0 +1,241 @@
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/luisdibdin/adaptivecard/internal/orderedjson"
+)
+
+// Validation rules.
+const (
+	RuleRequired     = "required"
+	RuleTableColumns = "table-columns"
+	RuleDuplicateID  = "duplicate-id"
+	RuleVersion      = "version"
+	RuleMarshal      = "marshal"
+	RuleSchema       = "schema"
+	RuleEnum         = "enum"
+	RuleMention      = "mention"
+	// RuleIcon flags icon names outside the IconName catalog. They are
+	// marshaled as given, but may render as a blank square.
+	RuleIcon = "icon"
+)
+
+// ValidationError is one problem found by Validate, with the JSON path of
+// the offending element or action and a machine-readable rule.
+type ValidationError struct {
+	Path    string `json:"path"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("adaptivecard: %s: %s", e.Path, e.Message)
+}
+
+// Validate checks the card for mistakes hosts reject or render wrongly:
+// missing type or version, TextBlocks without text, Action.OpenUrl without a
+// URL, table rows whose cell count differs from the column count, element
+// IDs used more than once (including inside Action.ShowCard cards) and
+// unknown weight, size, color, spacing and container style values or icon
+// names. It also runs ValidateForVersion for the card's declared version and
+// reports <at> placeholders and msteams mention entities that do not match.
+func (c AdaptiveCard) Validate() []ValidationError {
+	v := cardValidator{ids: map[string]string{}}
+	if c.Type == "" {
+		v.add("$.type", RuleRequired, "card type is empty")
+	}
+	if c.Version == "" {
+		v.add("$.version", RuleRequired, "card version is empty")
+	}
+	v.card(c, "$")
+	v.errs = append(v.errs, c.mentionErrors()...)
+	if c.Version != "" {
+		v.errs = append(v.errs, c.ValidateForVersion(c.Version)...)
+	}
+	return v.errs
+}
+
+// ValidateForVersion reports every element, action and property in the card
+// that schema version does not support, e.g. a Table (1.5) in a 1.2 card or
+// Action.mode (1.5) in a 1.4 card. Hosts silently drop or refuse such
+// content; DowngradeTo rewrites it instead. Middleware registered with Use
+// does not run.
+func (c AdaptiveCard) ValidateForVersion(version string) []ValidationError {
+	data, err := json.Marshal(c.withoutMiddleware())
+	if err != nil {
+		return []ValidationError{{Path: "$", Rule: RuleMarshal, Message: err.Error()}}
+	}
+	tree, err := orderedjson.Parse(data)
+	if err != nil {
+		return []ValidationError{{Path: "$", Rule: RuleMarshal, Message: err.Error()}}
+	}
+	var v cardValidator
+	v.versions("$", tree, version)
+	return v.errs
+}
+
+type cardValidator struct {
+	errs []ValidationError
+	// ids maps each element ID to the path where it was first seen.
+	ids map[string]string
+}
+
+func (v *cardValidator) add(path, rule, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *cardValidator) card(c AdaptiveCard, path string) {
+	c.Body = resolveConditionals(c.Body)
+	_ = walk(c.Body, path+".body", func(path string, el Element) error {
+		v.element(path, el)
+		for _, sel := range selectActions(path, el) {
+			v.action(sel.path, sel.action)
+		}
+		if as, ok := el.(ActionSet); ok {
+			for i, a := range flatActions(as.Actions) {
+				v.action(fmt.Sprintf("%s.actions[%d]", path, i), a)
+			}
+		}
+		return nil
+	})
+	for i, a := range flatActions(c.Actions) {
+		v.action(fmt.Sprintf("%s.actions[%d]", path, i), a)
+	}
+	if c.SelectAction != nil {
+		v.action(path+".selectAction", *c.SelectAction)
+	}
+	if c.Authentication != nil {
+		for i, b := range c.Authentication.Buttons {
+			buttonPath := fmt.Sprintf("%s.authentication.buttons[%d]", path, i)
+			if b.Type == "" {
+				v.add(buttonPath+".type", RuleRequired, "authentication button has no type")
+			}
+			if b.Value == "" {
+				v.add(buttonPath+".value", RuleRequired, "authentication button has no value")
+			}
+		}
+	}
+}
+
+func (v *cardValidator) element(path string, el Element) {
+	if b, ok := baseOf(el); ok {
+		if first, dup := v.ids[b.ID]; dup && b.ID != "" {
+			v.add(path+".id", RuleDuplicateID, "id %q is already used at %s", b.ID, first)
+		} else if b.ID != "" {
+			v.ids[b.ID] = path
+		}
+		v.enum(path+".spacing", "spacing", b.Spacing)
+	}
+	switch el := el.(type) {
+	case TextBlock:
+		if el.Text == "" {
+			v.add(path+".text", RuleRequired, "TextBlock has no text")
+		}
+		v.enum(path+".weight", "weight", el.Weight)
+		v.enum(path+".size", "size", el.Size)
+		v.enum(path+".color", "color", el.Color)
+	case RichTextBlock:
+		for i, run := range el.Inlines {
+			runPath := fmt.Sprintf("%s.inlines[%d]", path, i)
+			v.enum(runPath+".weight", "weight", run.Weight)
+			v.enum(runPath+".size", "size", run.Size)
+			v.enum(runPath+".color", "color", run.Color)
+		}
+	case Container:
+		v.enum(path+".style", "container style", el.Style)
+	case Icon:
+		v.enum(path+".color", "color", el.Color)
+		v.icon(path+".name", el.Name)
+	case Badge:
+		if el.Icon != "" {
+			v.icon(path+".icon", el.Icon)
+		}
+	case ProgressBar:
+		v.enum(path+".color", "color", el.Color)
+	case Table:
+		v.enum(path+".gridStyle", "container style", el.GridStyle)
+		for i, r := range el.Rows {
+			rowPath := fmt.Sprintf("%s.rows[%d]", path, i)
+			v.enum(rowPath+".style", "container style", r.Style)
+			for j, cell := range r.Cells {
+				v.enum(fmt.Sprintf("%s.cells[%d].style", rowPath, j), "container style", cell.Style)
+			}
+		}
+		if len(el.Columns) == 0 {
+			return
+		}
+		for i, r := range el.Rows {
+			if len(r.Cells) != len(el.Columns) {
+				v.add(fmt.Sprintf("%s.rows[%d]", path, i), RuleTableColumns,
+					"row has %s but the table has %s", plural(len(r.Cells), "cell"), plural(len(el.Columns), "column"))
+			}
+		}
+	}
+}
+
+func (v *cardValidator) enum(path, what string, value interface{ Valid() bool }) {
+	if !value.Valid() {
+		v.add(path, RuleEnum, "unknown %s %q", what, value)
+	}
+}
+
+func (v *cardValidator) icon(path string, name IconName) {
+	if !name.Valid() {
+		v.add(path, RuleIcon, "icon name %q is not in the catalog", name)
+	}
+}
+
+// versions checks the marshaled value at path against the version tables.
+func (v *cardValidator) versions(path string, value any, version string) {
+	switch value := value.(type) {
+	case *orderedjson.Object:
+		typ, _ := value.Get("type")
+		t, _ := typ.(string)
+		if since, ok := typeVersions[t]; ok && compareVersions(version, since) < 0 {
+			v.add(path, RuleVersion, "%s requires version %s, card is %s", t, since, version)
+		}
+		for _, m := range value.Members {
+			if since, ok := propertySince(t, m.Key); ok && compareVersions(version, since) < 0 {
+				v.add(path+"."+m.Key, RuleVersion, "%s.%s requires version %s, card is %s", t, m.Key, since, version)
+			}
+			v.versions(path+"."+m.Key, m.Value, version)
+		}
+	case []any:
+		for i, item := range value {
+			v.versions(fmt.Sprintf("%s[%d]", path, i), item, version)
+		}
+	}
+}
+
+// propertySince returns the version that introduced key on objects of type
+// typ, when it is later than the type itself.
+func propertySince(typ, key string) (string, bool) {
+	if since, ok := propertyVersions[typ+"."+key]; ok {
+		return since, true
+	}
+	if typ == "" || typ == "AdaptiveCard" {
+		return "", false
+	}
+	prefix := "Element."
+	if strings.HasPrefix(typ, "Action.") {
+		prefix = "Action."
+	}
+	since, ok := propertyVersions[prefix+key]
+	return since, ok
+}
+
+func (v *cardValidator) action(path string, a Action) {
+	switch a.Type {
+	case "Action.OpenUrl":
+		if a.Url == "" {
+			v.add(path+".url", RuleRequired, "Action.OpenUrl has no url")
+		}
+	case "Action.ShowCard":
+		if a.Card != nil {
+			v.card(*a.Card, path+".card")
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package adaptivecard
+
+import (
+	"strconv"
+	"strings"
+)
+
+// compareVersions compares two "major.minor" schema versions, returning -1,
+// 0 or 1. Missing or malformed parts count as zero.
+func compareVersions(a, b string) int {
+	ap, bp := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(ap), len(bp)); i++ {
+		var x, y int
+		if i < len(ap) {
+			x, _ = strconv.Atoi(ap[i])
+		}
+		if i < len(bp) {
+			y, _ = strconv.Atoi(bp[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// supportsType reports whether a card of version can contain typ.
+func supportsType(version, typ string) bool {
+	since, ok := typeVersions[typ]
+	return !ok || compareVersions(version, since) >= 0
+}
@@ -0,0 +1,164 @@
+package adaptivecard
+
+import "fmt"
+
+// child is a nested element together with its JSON path relative to its parent.
+type child struct {
+	path string
+	el   Element
+}
+
+// parent is implemented by elements that nest other elements.
+type parent interface {
+	children() []child
+	// mapChildren returns a copy of the element with fn applied to each
+	// nested element. The receiver's slices are not modified.
+	mapChildren(fn func(Element) Element) Element
+}
+
+// walk calls fn for every element in elements and, depth first, for every
+// element nested inside them. path is the JSON path of the elements slice,
+// e.g. "$.body". Walking stops at the first error returned by fn.
+func walk(elements []Element, path string, fn func(path string, el Element) error) error {
+	for i, el := range elements {
+		if err := walkElement(el, fmt.Sprintf("%s[%d]", path, i), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkElement(el Element, path string, fn func(path string, el Element) error) error {
+	// A Conditional is transparent: its element is walked in its place, at
+	// the same path, or nothing when it is excluded.
+	if el = unwrapConditional(el); el == nil {
+		return nil
+	}
+	if err := fn(path, el); err != nil {
+		return err
+	}
+	p, ok := el.(parent)
+	if !ok {
+		return nil
+	}
+	for _, c := range p.children() {
+		if err := walkElement(c.el, path+"."+c.path, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c Container) children() []child {
+	return itemChildren("items", c.Items)
+}
+
+func (cs ColumnSet) children() []child {
+	var out []child
+	for i, col := range cs.Columns {
+		out = append(out, itemChildren(fmt.Sprintf("columns[%d].items", i), col.Items)...)
+	}
+	return out
+}
+
+func (t Table) children() []child {
+	var out []child
+	for i, r := range t.Rows {
+		for j, cell := range r.Cells {
+			out = append(out, itemChildren(fmt.Sprintf("rows[%d].cells[%d].items", i, j), cell.Items)...)
+		}
+	}
+	return out
+}
+
+func (c Container) mapChildren(fn func(Element) Element) Element {
+	c.Items = mapItems(c.Items, fn)
+	return c
+}
+
+func (cs ColumnSet) mapChildren(fn func(Element) Element) Element {
+	columns := make([]Column, len(cs.Columns))
+	for i, col := range cs.Columns {
+		col.Items = mapItems(col.Items, fn)
+		columns[i] = col
+	}
+	cs.Columns = columns
+	return cs
+}
+
+func (t Table) mapChildren(fn func(Element) Element) Element {
+	rows := make([]TableRow, len(t.Rows))
+	for i, r := range t.Rows {
+		cells := make([]TableCell, len(r.Cells))
+		for j, cell := range r.Cells {
+			cell.Items = mapItems(cell.Items, fn)
+			cells[j] = cell
+		}
+		r.Cells = cells
+		rows[i] = r
+	}
+	t.Rows = rows
+	return t
+}
+
+// transform returns a copy of elements with fn applied to every element in
+// the tree, children before their parents. The input is never modified.
+func transform(elements []Element, fn func(Element) Element) []Element {
+	return transformPaths(elements, "", func(_ string, el Element) Element {
+		return fn(el)
+	})
+}
+
+// transformPaths is transform with the JSON path of each element, rooted at
+// path. It relies on mapChildren visiting children in the order children()
+// lists them.
+func transformPaths(elements []Element, path string, fn func(path string, el Element) Element) []Element {
+	if elements == nil {
+		return nil
+	}
+	out := make([]Element, len(elements))
+	for i, el := range elements {
+		out[i] = transformElement(el, fmt.Sprintf("%s[%d]", path, i), fn)
+	}
+	return out
+}
+
+func transformElement(el Element, path string, fn func(path string, el Element) Element) Element {
+	// Conditionals stay in place, so their predicates are still evaluated
+	// at marshal time, but their element is transformed like any other.
+	if c, ok := el.(Conditional); ok {
+		if c.Element != nil {
+			c.Element = transformElement(c.Element, path, fn)
+		}
+		return c
+	}
+	if p, ok := el.(parent); ok {
+		kids := p.children()
+		i := 0
+		el = p.mapChildren(func(child Element) Element {
+			childPath := path + "." + kids[i].path
+			i++
+			return transformElement(child, childPath, fn)
+		})
+	}
+	return fn(path, el)
+}
+
+func mapItems(items []Element, fn func(Element) Element) []Element {
+	if items == nil {
+		return nil
+	}
+	out := make([]Element, len(items))
+	for i, el := range items {
+		out[i] = fn(el)
+	}
+	return out
+}
+
+func itemChildren(path string, items []Element) []child {
+	out := make([]child, len(items))
+	for i, el := range items {
+		out[i] = child{path: fmt.Sprintf("%s[%d]", path, i), el: el}
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package adaptivecard
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWalkPaths(t *testing.T) {
+	table := NewTable()
+	table.Rows = []TableRow{NewTableRow(NewTableCell(NewTextBlock("cell")))}
+
+	tests := []struct {
+		name     string
+		elements []Element
+		want     []string
+	}{
+		{
+			name:     "flat",
+			elements: []Element{NewTextBlock("a"), NewImage("https://example.com/a.png")},
+			want:     []string{"$.body[0]", "$.body[1]"},
+		},
+		{
+			name:     "container",
+			elements: []Element{NewContainer(NewTextBlock("a"), NewContainer(NewTextBlock("b")))},
+			want:     []string{"$.body[0]", "$.body[0].items[0]", "$.body[0].items[1]", "$.body[0].items[1].items[0]"},
+		},
+		{
+			name:     "column set",
+			elements: []Element{NewColumnSet(NewColumn(), NewColumn(NewTextBlock("a")))},
+			want:     []string{"$.body[0]", "$.body[0].columns[1].items[0]"},
+		},
+		{
+			name:     "table",
+			elements: []Element{table},
+			want:     []string{"$.body[0]", "$.body[0].rows[0].cells[0].items[0]"},
+		},
+		{
+			name:     "conditionals",
+			elements: []Element{When(false, NewTextBlock("hidden")), NewContainer(When(true, NewTextBlock("shown")))},
+			want:     []string{"$.body[1]", "$.body[1].items[0]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var walked []string
+			_ = walk(tt.elements, "$.body", func(path string, _ Element) error {
+				walked = append(walked, path)
+				return nil
+			})
+			if !reflect.DeepEqual(walked, tt.want) {
+				t.Errorf("walk paths = %q, want %q", walked, tt.want)
+			}
+
+			// transformPaths reports the same paths, children first, and
+			// also visits excluded conditionals' elements.
+			seen := map[string]bool{}
+			_ = transformPaths(tt.elements, "$.body", func(path string, el Element) Element {
+				seen[path] = true
+				return el
+			})
+			for _, p := range tt.want {
+				if !seen[p] {
+					t.Errorf("transformPaths did not visit %s", p)
+				}
+			}
+		})
+	}
+}
+
+func TestTransformDoesNotModifyInput(t *testing.T) {
+	table := NewTable()
+	table.Rows = []TableRow{NewTableRow(NewTableCell(NewTextBlock("cell")))}
+	elements := []Element{
+		NewContainer(NewTextBlock("a")),
+		NewColumnSet(NewColumn(NewTextBlock("b"))),
+		table,
+	}
+	before := mustMarshal(t, newTestCard(elements...))
+
+	out := transform(elements, func(el Element) Element {
+		if tb, ok := el.(TextBlock); ok {
+			tb.Text = "changed"
+			return tb
+		}
+		return el
+	})
+
+	if after := mustMarshal(t, newTestCard(elements...)); after != before {
+		t.Errorf("input modified:\n%s\n%s", before, after)
+	}
+	var texts []string
+	_ = walk(out, "$", func(_ string, el Element) error {
+		if tb, ok := el.(TextBlock); ok {
+			texts = append(texts, tb.Text)
+		}
+		return nil
+	})
+	if want := []string{"changed", "changed", "changed"}; !reflect.DeepEqual(texts, want) {
+		t.Errorf("transformed texts = %q, want %q", texts, want)
+	}
+}